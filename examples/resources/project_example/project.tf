@@ -49,14 +49,13 @@ resource "zeet_project" "container" {
     workflow = {
       deploy_timeout_seconds = 300
     }
-    kubernetes = jsonencode({
-      deployTarget = {
-        deployTarget = "KUBERNETES"
-        clusterID    = var.cluster_id
+    kubernetes_config = {
+      deploy_target = {
+        cluster_id = var.cluster_id
       }
       namespace = var.team_id
       app = {
-        deployService = true
+        deploy_service = true
         ports = [
           {
             port     = "80"
@@ -66,7 +65,7 @@ resource "zeet_project" "container" {
           }
         ]
       }
-    })
+    }
   }
 }
 
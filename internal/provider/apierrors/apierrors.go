@@ -0,0 +1,241 @@
+// Package apierrors classifies the errors returned by the Zeet GraphQL API client.
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Kind is the category of an API error.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindNotFound
+	KindUnauthorized
+	KindForbidden
+	KindValidation
+	KindConflict
+	KindRateLimited
+	KindTransient
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNotFound:
+		return "not found"
+	case KindUnauthorized:
+		return "unauthorized"
+	case KindForbidden:
+		return "forbidden"
+	case KindValidation:
+		return "validation"
+	case KindConflict:
+		return "conflict"
+	case KindRateLimited:
+		return "rate limited"
+	case KindTransient:
+		return "transient"
+	default:
+		return "unknown"
+	}
+}
+
+// Error is a classified API error.
+type Error struct {
+	Kind Kind
+	// Path is the input field path reported by the API for validation errors, e.g. ["input", "name"]
+	Path []string
+	// StatusCode is the HTTP status code when the request failed before GraphQL processing
+	StatusCode int
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// genqlient reports non 200 responses as "returned error 502 Bad Gateway: <body>"
+var statusCodeRegexp = regexp.MustCompile(`returned error (\d{3})`)
+
+// Classify returns the classified error, or nil when err is nil.
+func Classify(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified
+	}
+
+	classified = &Error{Kind: KindUnknown, Err: err}
+
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		classified.Kind = kindFromCode(gqlErr.Extensions["code"])
+		classified.Path = fieldPath(gqlErr.Extensions["field"])
+		if classified.Kind == KindUnknown {
+			classified.Kind = kindFromMessage(gqlErr.Message)
+		}
+		return classified
+	}
+
+	if m := statusCodeRegexp.FindStringSubmatch(err.Error()); m != nil {
+		classified.StatusCode, _ = strconv.Atoi(m[1])
+		classified.Kind = kindFromStatusCode(classified.StatusCode)
+		return classified
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		classified.Kind = KindUnknown
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		classified.Kind = KindTransient
+	case errors.As(err, &netErr) && netErr.Timeout():
+		classified.Kind = KindTransient
+	default:
+		classified.Kind = kindFromMessage(err.Error())
+	}
+	return classified
+}
+
+// KindOf returns the category of err.
+func KindOf(err error) Kind {
+	if err == nil {
+		return KindUnknown
+	}
+	return Classify(err).Kind
+}
+
+func IsNotFound(err error) bool {
+	return KindOf(err) == KindNotFound
+}
+
+func IsUnauthorized(err error) bool {
+	return KindOf(err) == KindUnauthorized
+}
+
+func IsForbidden(err error) bool {
+	return KindOf(err) == KindForbidden
+}
+
+func IsValidation(err error) bool {
+	return KindOf(err) == KindValidation
+}
+
+func IsConflict(err error) bool {
+	return KindOf(err) == KindConflict
+}
+
+func IsRateLimited(err error) bool {
+	return KindOf(err) == KindRateLimited
+}
+
+// IsRetryable reports whether the request can be sent again, as is, later.
+func IsRetryable(err error) bool {
+	kind := KindOf(err)
+	return kind == KindRateLimited || kind == KindTransient
+}
+
+func kindFromCode(code any) Kind {
+	s, _ := code.(string)
+	switch strings.ToUpper(s) {
+	case "NOT_FOUND":
+		return KindNotFound
+	case "UNAUTHENTICATED", "UNAUTHORIZED":
+		return KindUnauthorized
+	case "FORBIDDEN":
+		return KindForbidden
+	case "BAD_USER_INPUT", "GRAPHQL_VALIDATION_FAILED", "GRAPHQL_PARSE_FAILED", "VALIDATION_ERROR":
+		return KindValidation
+	case "CONFLICT", "ALREADY_EXISTS":
+		return KindConflict
+	case "RATE_LIMITED", "TOO_MANY_REQUESTS":
+		return KindRateLimited
+	case "SERVICE_UNAVAILABLE", "TIMEOUT":
+		return KindTransient
+	default:
+		return KindUnknown
+	}
+}
+
+func kindFromStatusCode(code int) Kind {
+	switch {
+	case code == 400 || code == 422:
+		return KindValidation
+	case code == 401:
+		return KindUnauthorized
+	case code == 403:
+		return KindForbidden
+	case code == 404:
+		return KindNotFound
+	case code == 409:
+		return KindConflict
+	case code == 429:
+		return KindRateLimited
+	case code == 502 || code == 503 || code == 504:
+		return KindTransient
+	default:
+		return KindUnknown
+	}
+}
+
+// kindFromMessage is the fallback for errors without a code. Only the exact "record not found" message of the API
+// means not found, other messages mentioning "not found" can be about a referenced record, e.g. a missing cluster.
+func kindFromMessage(msg string) Kind {
+	msg = strings.ToLower(strings.TrimSpace(msg))
+	switch {
+	case msg == "record not found":
+		return KindNotFound
+	case strings.Contains(msg, "unauthorized"), strings.Contains(msg, "unauthenticated"):
+		return KindUnauthorized
+	case strings.Contains(msg, "forbidden"), strings.Contains(msg, "permission denied"):
+		return KindForbidden
+	case strings.Contains(msg, "already exists"), strings.Contains(msg, "conflict"):
+		return KindConflict
+	case strings.Contains(msg, "rate limit"), strings.Contains(msg, "too many requests"):
+		return KindRateLimited
+	case strings.Contains(msg, "connection reset"), strings.Contains(msg, "bad gateway"):
+		return KindTransient
+	default:
+		return KindUnknown
+	}
+}
+
+func fieldPath(field any) []string {
+	switch f := field.(type) {
+	case string:
+		if f == "" {
+			return nil
+		}
+		return strings.Split(f, ".")
+	case []any:
+		path := make([]string, 0, len(f))
+		for _, p := range f {
+			switch v := p.(type) {
+			case string:
+				path = append(path, v)
+			case float64:
+				path = append(path, strconv.Itoa(int(v)))
+			}
+		}
+		return path
+	default:
+		return nil
+	}
+}
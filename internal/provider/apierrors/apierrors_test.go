@@ -0,0 +1,106 @@
+package apierrors_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		kind apierrors.Kind
+		path []string
+	}{
+		{
+			name: "graphql code",
+			err:  gqlerror.List{{Message: "no access", Extensions: map[string]interface{}{"code": "FORBIDDEN"}}},
+			kind: apierrors.KindForbidden,
+		},
+		{
+			name: "graphql validation with field",
+			err: gqlerror.List{{Message: "name is invalid", Extensions: map[string]interface{}{
+				"code":  "BAD_USER_INPUT",
+				"field": []interface{}{"input", "name"},
+			}}},
+			kind: apierrors.KindValidation,
+			path: []string{"input", "name"},
+		},
+		{
+			name: "graphql message",
+			err:  gqlerror.List{{Message: "record not found"}},
+			kind: apierrors.KindNotFound,
+		},
+		{
+			name: "graphql message about a referenced record",
+			err:  gqlerror.List{{Message: "cluster not found in team"}},
+			kind: apierrors.KindUnknown,
+		},
+		{
+			name: "graphql not found code",
+			err:  gqlerror.List{{Message: "project does not exist", Extensions: map[string]interface{}{"code": "NOT_FOUND"}}},
+			kind: apierrors.KindNotFound,
+		},
+		{
+			name: "http not found",
+			err:  errors.New("returned error 404 Not Found: "),
+			kind: apierrors.KindNotFound,
+		},
+		{
+			name: "wrapped graphql message",
+			err:  fmt.Errorf("read project: %w", gqlerror.List{{Message: "group already exists"}}),
+			kind: apierrors.KindConflict,
+		},
+		{
+			name: "http unauthorized",
+			err:  errors.New("returned error 401 Unauthorized: {}"),
+			kind: apierrors.KindUnauthorized,
+		},
+		{
+			name: "http too many requests",
+			err:  errors.New("returned error 429 Too Many Requests: slow down"),
+			kind: apierrors.KindRateLimited,
+		},
+		{
+			name: "http bad gateway",
+			err:  errors.New("returned error 502 Bad Gateway: "),
+			kind: apierrors.KindTransient,
+		},
+		{
+			name: "connection closed",
+			err:  fmt.Errorf("Post \"https://zeet.co/graphql\": %w", io.ErrUnexpectedEOF),
+			kind: apierrors.KindTransient,
+		},
+		{
+			name: "unknown",
+			err:  errors.New("something went wrong"),
+			kind: apierrors.KindUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := apierrors.Classify(tt.err)
+			if got.Kind != tt.kind {
+				t.Errorf("expected kind %s, got %s", tt.kind, got.Kind)
+			}
+			if !reflect.DeepEqual(got.Path, tt.path) {
+				t.Errorf("expected path %v, got %v", tt.path, got.Path)
+			}
+			if !reflect.DeepEqual(got.Unwrap(), tt.err) {
+				t.Errorf("expected classified error to wrap %v", tt.err)
+			}
+		})
+	}
+
+	if apierrors.Classify(nil) != nil {
+		t.Error("expected nil for nil error")
+	}
+}
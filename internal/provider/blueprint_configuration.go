@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+)
+
+// The blueprintById query of the SDK does not select the driver configuration nor the variable specs.
+const blueprintConfigurationOperation = `
+query blueprintConfiguration ($blueprintId: UUID!) {
+	blueprint(id: $blueprintId) {
+		id
+		configuration {
+			driverConfiguration {
+				__typename
+				... on BlueprintTerraformConfiguration {
+					source {
+						... ProjectSourceDetail
+					}
+					terraformVersion
+					outputConfiguration {
+						automatic {
+							disabled
+							excluded
+							sensitive
+						}
+						customization
+					}
+				}
+				... on BlueprintManifestConfiguration {
+					source {
+						... ProjectSourceDetail
+					}
+					useKustomize
+				}
+				... on BlueprintHelmConfiguration {
+					source {
+						... ProjectSourceDetail
+					}
+				}
+			}
+			variables {
+				name
+				type
+				required
+			}
+		}
+	}
+}
+fragment ProjectSourceDetail on Source {
+	git {
+		repository
+		ref
+		path
+		integration {
+			githubInstallationId
+			githubIntegrationId
+			gitlabIntegrationId
+		}
+	}
+	terraformModule {
+		source
+		version
+		integration {
+			git {
+				githubInstallationId
+			}
+		}
+	}
+	helmRepository {
+		repositoryUrl
+		chart
+		version
+	}
+	containerRegistry {
+		registryId
+		registryUrl
+		repository
+		tag
+		digest
+	}
+}
+`
+
+// blueprintDrivers maps the driver configuration types to their field in BlueprintDriverConfigurationInput.
+var blueprintDrivers = map[string]string{
+	"BlueprintTerraformConfiguration": "terraform",
+	"BlueprintManifestConfiguration":  "manifest",
+	"BlueprintHelmConfiguration":      "helm",
+}
+
+type blueprintConfigurationResponse struct {
+	Blueprint *struct {
+		Id            uuid.UUID `json:"id"`
+		Configuration struct {
+			DriverConfiguration json.RawMessage         `json:"driverConfiguration"`
+			Variables           []blueprintVariableSpec `json:"variables"`
+		} `json:"configuration"`
+	} `json:"blueprint"`
+}
+
+// blueprintVariableSpec fields are sorted like the keys of a jsonencode object.
+type blueprintVariableSpec struct {
+	Name     string                       `json:"name"`
+	Required bool                         `json:"required"`
+	Type     zeetv1.BlueprintVariableType `json:"type"`
+}
+
+// blueprintConfiguration is the driver configuration and the variable specs of a blueprint.
+type blueprintConfiguration struct {
+	// DriverConfiguration is in the BlueprintDriverConfigurationInput format, empty if the blueprint has none
+	DriverConfiguration string
+	Variables           []blueprintVariableSpec
+}
+
+func blueprintConfigurationQuery(ctx context.Context, client graphql.Client, blueprintId uuid.UUID) (*blueprintConfiguration, error) {
+	var data blueprintConfigurationResponse
+	err := client.MakeRequest(ctx, &graphql.Request{
+		OpName: "blueprintConfiguration",
+		Query:  blueprintConfigurationOperation,
+		Variables: map[string]any{
+			"blueprintId": blueprintId,
+		},
+	}, &graphql.Response{Data: &data})
+	if err != nil {
+		return nil, err
+	}
+	if data.Blueprint == nil {
+		return nil, fmt.Errorf("blueprint not found")
+	}
+
+	result := &blueprintConfiguration{
+		Variables: lo.Ternary(data.Blueprint.Configuration.Variables != nil, data.Blueprint.Configuration.Variables, []blueprintVariableSpec{}),
+	}
+	if len(data.Blueprint.Configuration.DriverConfiguration) == 0 || string(data.Blueprint.Configuration.DriverConfiguration) == "null" {
+		return result, nil
+	}
+
+	var driverConfiguration map[string]any
+	if err := json.Unmarshal(data.Blueprint.Configuration.DriverConfiguration, &driverConfiguration); err != nil {
+		return nil, err
+	}
+	typename, _ := driverConfiguration["__typename"].(string)
+	driver, ok := blueprintDrivers[typename]
+	if !ok {
+		return nil, fmt.Errorf("driver configuration type %q not supported", typename)
+	}
+	delete(driverConfiguration, "__typename")
+
+	b, err := json.Marshal(map[string]any{driver: withoutNulls(driverConfiguration)})
+	if err != nil {
+		return nil, err
+	}
+	result.DriverConfiguration = string(b)
+	return result, nil
+}
+
+// withoutNulls removes the null fields of a JSON value, such as the sources not used by a driver configuration, so
+// that it matches the configuration it was created with.
+func withoutNulls(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if value == nil {
+				delete(v, key)
+			} else {
+				v[key] = withoutNulls(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = withoutNulls(value)
+		}
+	}
+	return v
+}
+
+// The SDK has no v1 blueprint mutations, the v0 ones take a different driver configuration format.
+const createBlueprintOperation = `
+mutation createBlueprint ($input: CreateBlueprintInput!) {
+	createBlueprint(input: $input) {
+		id
+	}
+}
+`
+
+const updateBlueprintOperation = `
+mutation updateBlueprint ($id: UUID!, $input: UpdateBlueprintInput!) {
+	updateBlueprint(id: $id, input: $input) {
+		id
+	}
+}
+`
+
+const deleteBlueprintOperation = `
+mutation deleteBlueprint ($id: UUID!) {
+	deleteBlueprint(id: $id)
+}
+`
+
+// blueprintInput is either a CreateBlueprintInput or an UpdateBlueprintInput, TeamId and Type can only be set on
+// create.
+type blueprintInput struct {
+	TeamId              *uuid.UUID              `json:"teamId,omitempty"`
+	Type                zeetv1.BlueprintType    `json:"type,omitempty"`
+	Slug                string                  `json:"slug"`
+	DisplayName         string                  `json:"displayName"`
+	Published           bool                    `json:"published"`
+	Enabled             *bool                   `json:"enabled,omitempty"`
+	Description         *string                 `json:"description,omitempty"`
+	Tags                []string                `json:"tags"`
+	DriverConfiguration json.RawMessage         `json:"driverConfiguration,omitempty"`
+	RichInputSchema     json.RawMessage         `json:"richInputSchema,omitempty"`
+	Variables           []blueprintVariableSpec `json:"variables"`
+}
+
+func createBlueprintMutation(ctx context.Context, client graphql.Client, input blueprintInput) (uuid.UUID, error) {
+	var data struct {
+		CreateBlueprint struct {
+			Id uuid.UUID `json:"id"`
+		} `json:"createBlueprint"`
+	}
+	err := client.MakeRequest(ctx, &graphql.Request{
+		OpName: "createBlueprint",
+		Query:  createBlueprintOperation,
+		Variables: map[string]any{
+			"input": input,
+		},
+	}, &graphql.Response{Data: &data})
+	return data.CreateBlueprint.Id, err
+}
+
+func updateBlueprintMutation(ctx context.Context, client graphql.Client, id uuid.UUID, input blueprintInput) error {
+	var data struct {
+		UpdateBlueprint struct {
+			Id uuid.UUID `json:"id"`
+		} `json:"updateBlueprint"`
+	}
+	return client.MakeRequest(ctx, &graphql.Request{
+		OpName: "updateBlueprint",
+		Query:  updateBlueprintOperation,
+		Variables: map[string]any{
+			"id":    id,
+			"input": input,
+		},
+	}, &graphql.Response{Data: &data})
+}
+
+func deleteBlueprintMutation(ctx context.Context, client graphql.Client, id uuid.UUID) error {
+	var data struct {
+		DeleteBlueprint bool `json:"deleteBlueprint"`
+	}
+	return client.MakeRequest(ctx, &graphql.Request{
+		OpName: "deleteBlueprint",
+		Query:  deleteBlueprintOperation,
+		Variables: map[string]any{
+			"id": id,
+		},
+	}, &graphql.Response{Data: &data})
+}
@@ -2,15 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
 
-	"github.com/zeet-dev/cli/pkg/api"
 	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
 	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
@@ -25,13 +27,14 @@ func NewBlueprintDataSource() datasource.DataSource {
 
 // BlueprintDataSource defines the data source implementation.
 type BlueprintDataSource struct {
-	client *api.Client
+	client *zeetClient
 }
 
 // BlueprintDataSourceModel describes the data source data model.
 type BlueprintDataSourceModel struct {
 	Id            customtypes.UUIDValue        `tfsdk:"id"`
 	Slug          types.String                 `tfsdk:"slug"`
+	TeamId        customtypes.UUIDValue        `tfsdk:"team_id"`
 	IsOfficial    types.Bool                   `tfsdk:"is_official"`
 	Type          types.String                 `tfsdk:"type"`
 	Configuration *BlueprintConfigurationModel `tfsdk:"configuration"`
@@ -47,6 +50,14 @@ type BlueprintConfigurationModel struct {
 	DriverConfiguration jsontypes.Normalized `tfsdk:"driver_configuration"`
 	RichInputSchema     jsontypes.Normalized `tfsdk:"rich_input_schema"`
 	Variables           jsontypes.Normalized `tfsdk:"variables"`
+
+	VariableSpecs []BlueprintVariableSpecModel `tfsdk:"variable_specs"`
+}
+
+type BlueprintVariableSpecModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Required types.Bool   `tfsdk:"required"`
 }
 
 func (d *BlueprintDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,9 +75,14 @@ func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				CustomType:          customtypes.UUIDType{},
 			},
 			"slug": schema.StringAttribute{
-				MarkdownDescription: "Blueprint slug, either id or slug must be set, can only be used for official blueprints",
+				MarkdownDescription: "Blueprint slug, either id or slug must be set, looked up in the official blueprints unless `team_id` is set",
 				Optional:            true,
 			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team identifier, the slug is looked up in the team blueprint catalog when set",
+				Optional:            true,
+				CustomType:          customtypes.UUIDType{},
+			},
 			"is_official": schema.BoolAttribute{
 				MarkdownDescription: "Blueprint is official",
 				Computed:            true,
@@ -105,7 +121,7 @@ func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaR
 						Computed:            true,
 					},
 					"driver_configuration": schema.StringAttribute{
-						MarkdownDescription: "Blueprint driver configuration in [JSON format](https://docs.zeet.co/graphql/objects/blueprint-configuration/#code-style-fontweight-normal-blueprintconfigurationbdriverconfigurationbcodeblueprintdriverconfiguration-)",
+						MarkdownDescription: "Blueprint driver configuration in JSON format, GraphQL type [`BlueprintDriverConfigurationInput`](https://docs.zeet.co/graphql/inputs/blueprint-driver-configuration-input/), null if the blueprint has none",
 						Computed:            true,
 						CustomType:          jsontypes.NormalizedType{},
 					},
@@ -119,6 +135,26 @@ func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaR
 						Computed:            true,
 						CustomType:          jsontypes.NormalizedType{},
 					},
+					"variable_specs": schema.ListNestedAttribute{
+						MarkdownDescription: "Blueprint variables",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Variable name",
+									Computed:            true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Variable [type](https://docs.zeet.co/graphql/enums/blueprint-variable-type/)",
+									Computed:            true,
+								},
+								"required": schema.BoolAttribute{
+									MarkdownDescription: "Indicates if the variable must be set by the projects using the blueprint",
+									Computed:            true,
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -131,12 +167,12 @@ func (d *BlueprintDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*zeetClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -160,18 +196,34 @@ func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 			resp.Diagnostics.AddError("Invalid Configuration", "Either id or slug must be set")
 			return
 		}
-		// query official blueprint by slug
-		result, err := zeetv0.MarketplaceBlueprintQuery(ctx, d.client.Client(), "zeet", data.Slug.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read blueprint, got error: %s", err))
-			return
+		if data.TeamId.IsNull() {
+			// query official blueprint by slug
+			result, err := zeetv0.MarketplaceBlueprintQuery(ctx, d.client.Client(), "zeet", data.Slug.ValueString())
+			if err != nil {
+				addClientError(&resp.Diagnostics, "read blueprint", err)
+				return
+			}
+			data.Id = customtypes.NewUUIDValue(result.BlueprintsMarketplace.Blueprint.Id)
+		} else {
+			// query the team blueprint catalog, which includes the official blueprints
+			blueprints, err := listTeamBlueprints(ctx, d.client, data.TeamId.ValueUUID())
+			if err != nil {
+				addClientError(&resp.Diagnostics, "read blueprint", err)
+				return
+			}
+			blueprint, ok := findBlueprintBySlug(blueprints, data.Slug.ValueString())
+			if !ok {
+				resp.Diagnostics.AddAttributeError(path.Root("slug"), "Invalid Configuration",
+					fmt.Sprintf("No blueprint with slug %q in the blueprints of team %s", data.Slug.ValueString(), data.TeamId.ValueUUID()))
+				return
+			}
+			data.Id = customtypes.NewUUIDValue(blueprint.Id)
 		}
-		data.Id = customtypes.NewUUIDValue(result.BlueprintsMarketplace.Blueprint.Id)
 	}
 
 	result, err := zeetv1.BlueprintByIdQuery(ctx, d.client.ClientV1(), data.Id.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read blueprint, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read blueprint", err)
 		return
 	}
 
@@ -183,8 +235,6 @@ func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 		Slug:        types.StringValue(result.Blueprint.Configuration.Slug),
 		DisplayName: types.StringValue(result.Blueprint.Configuration.DisplayName),
 		Published:   types.BoolValue(result.Blueprint.Configuration.Published),
-		// DriverConfiguration: jsontypes.NewNormalizedValue(result.Blueprint.Configuration),
-		// Variables: jsontypes.NewNormalizedValue(result.Blueprint.Configuration.Variables),
 	}
 
 	if result.Blueprint.Configuration.Description != nil {
@@ -206,6 +256,30 @@ func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 		data.Configuration.RichInputSchema = jsontypes.NewNormalizedValue(*result.Blueprint.Configuration.RichInputSchema)
 	}
 
+	configuration, err := blueprintConfigurationQuery(ctx, d.client.ClientV1(), data.Id.ValueUUID())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read blueprint", err)
+		return
+	}
+
+	if configuration.DriverConfiguration != "" {
+		data.Configuration.DriverConfiguration = jsontypes.NewNormalizedValue(configuration.DriverConfiguration)
+	}
+
+	variables, err := json.Marshal(configuration.Variables)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read blueprint, got error: %s", err))
+		return
+	}
+	data.Configuration.Variables = jsontypes.NewNormalizedValue(string(variables))
+	data.Configuration.VariableSpecs = lo.Map(configuration.Variables, func(v blueprintVariableSpec, _ int) BlueprintVariableSpecModel {
+		return BlueprintVariableSpecModel{
+			Name:     types.StringValue(v.Name),
+			Type:     types.StringValue(string(v.Type)),
+			Required: types.BoolValue(v.Required),
+		}
+	})
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "read a data source")
@@ -3,18 +3,70 @@ package provider_test
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/samber/lo"
 
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
 )
 
-func TestAccBlueprintDataSource(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+func testAccBlueprintServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(req), "query blueprintConfiguration") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"blueprint": map[string]any{
+						"id": testBlueprintId,
+						"configuration": map[string]any{
+							"driverConfiguration": map[string]any{
+								"__typename": "BlueprintTerraformConfiguration",
+								"source": map[string]any{
+									"terraformModule": map[string]any{
+										"source":  "terraform-aws-modules/route53/aws//modules/delegation-sets",
+										"version": "2.10.2",
+									},
+								},
+								"terraformVersion": "1.5.7",
+							},
+							"variables": []map[string]any{
+								{"name": "name", "type": "STRING", "required": true},
+								{"name": "ttl", "type": "INTEGER", "required": false},
+							},
+						},
+					},
+				},
+			})
+			return
+		}
+		if strings.Contains(string(req), "query blueprints (") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"currentUser": map[string]any{"id": "99c11487-1683-4e10-9620-94d9a78a0b67"},
+					"user": map[string]any{
+						"id": "99c11487-1683-4e10-9620-94d9a78a0b67",
+						"blueprints": map[string]any{
+							"nodes": []any{
+								map[string]any{"id": uuid.New(), "slug": "helm-chart", "type": "HELM", "isOfficial": true},
+								map[string]any{"id": testBlueprintId, "slug": "route53-delegation", "type": "TERRAFORM", "isOfficial": false},
+							},
+							"pageInfo": map[string]any{"hasNextPage": false},
+						},
+					},
+				},
+			})
+			return
+		}
 		json.NewEncoder(w).Encode(map[string]any{
 			"data": zeetv1.BlueprintByIdResponse{
 				Blueprint: &zeetv1.BlueprintByIdBlueprint{
@@ -38,6 +90,10 @@ func TestAccBlueprintDataSource(t *testing.T) {
 			},
 		})
 	}))
+}
+
+func TestAccBlueprintDataSource(t *testing.T) {
+	server := testAccBlueprintServer(t)
 	defer server.Close()
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -49,6 +105,14 @@ func TestAccBlueprintDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "id", testBlueprintId.String()),
 					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "slug", "route53-delegation"),
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "configuration.driver_configuration",
+						`{"terraform":{"source":{"terraformModule":{"source":"terraform-aws-modules/route53/aws//modules/delegation-sets","version":"2.10.2"}},"terraformVersion":"1.5.7"}}`),
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "configuration.variables",
+						`[{"name":"name","required":true,"type":"STRING"},{"name":"ttl","required":false,"type":"INTEGER"}]`),
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "configuration.variable_specs.#", "2"),
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "configuration.variable_specs.1.name", "ttl"),
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "configuration.variable_specs.1.type", "INTEGER"),
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "configuration.variable_specs.1.required", "false"),
 				),
 			},
 		},
@@ -64,3 +128,38 @@ data "zeet_blueprint" "test" {
   id = "2e9aa322-3a41-4930-9f3c-2987836d3b70"
 }
 `
+
+func TestAccBlueprintDataSourceTeamSlug(t *testing.T) {
+	server := testAccBlueprintServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(testAccBlueprintDataSourceTeamSlugConfig, server.URL, "route53-delegation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "id", testBlueprintId.String()),
+					resource.TestCheckResourceAttr("data.zeet_blueprint.test", "is_official", "false"),
+				),
+			},
+			// Slug not in the team catalog
+			{
+				Config:      fmt.Sprintf(testAccBlueprintDataSourceTeamSlugConfig, server.URL, "missing"),
+				ExpectError: regexp.MustCompile(`No blueprint with slug "missing" in the blueprints of team`),
+			},
+		},
+	})
+}
+
+const testAccBlueprintDataSourceTeamSlugConfig = `
+provider "zeet" {
+  api_url = "%s"
+}
+
+data "zeet_blueprint" "test" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  slug    = %q
+}
+`
@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BlueprintResource{}
+var _ resource.ResourceWithImportState = &BlueprintResource{}
+
+// blueprintInputFields maps the API blueprint input fields to their attributes.
+var blueprintInputFields = map[string]path.Path{
+	"slug":                path.Root("slug"),
+	"displayName":         path.Root("display_name"),
+	"driverConfiguration": path.Root("driver_configuration"),
+	"richInputSchema":     path.Root("rich_input_schema"),
+	"variables":           path.Root("variables"),
+}
+
+func NewBlueprintResource() resource.Resource {
+	return &BlueprintResource{}
+}
+
+// BlueprintResource defines the resource implementation.
+type BlueprintResource struct {
+	client *zeetClient
+}
+
+// BlueprintResourceModel describes the resource data model, the configuration attributes mirror
+// BlueprintConfigurationModel.
+type BlueprintResourceModel struct {
+	Id     customtypes.UUIDValue `tfsdk:"id"`
+	TeamId customtypes.UUIDValue `tfsdk:"team_id"`
+	Type   types.String          `tfsdk:"type"`
+
+	Slug                types.String         `tfsdk:"slug"`
+	DisplayName         types.String         `tfsdk:"display_name"`
+	Description         types.String         `tfsdk:"description"`
+	Tags                types.List           `tfsdk:"tags"`
+	Enabled             types.Bool           `tfsdk:"enabled"`
+	Published           types.Bool           `tfsdk:"published"`
+	DriverConfiguration jsontypes.Normalized `tfsdk:"driver_configuration"`
+	RichInputSchema     jsontypes.Normalized `tfsdk:"rich_input_schema"`
+	Variables           jsontypes.Normalized `tfsdk:"variables"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *BlueprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint"
+}
+
+func (r *BlueprintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom blueprint resource\n\nImport with an identifier in the format `team/blueprint`, the team being a name or an identifier and the blueprint a slug or an identifier.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Blueprint identifier",
+				CustomType:          customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team identifier",
+				Required:            true,
+				CustomType:          customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Blueprint [type](https://docs.zeet.co/graphql/enums/blueprint-type/)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(zeetv1.BlueprintTypeTerraform),
+						string(zeetv1.BlueprintTypeKubernetesManifest),
+						string(zeetv1.BlueprintTypeHelm),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Blueprint slug, unique within the team",
+				Required:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Blueprint display name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Blueprint description",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "List of tags associated with the blueprint",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the blueprint is enabled for the team",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the blueprint is available to new projects",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"driver_configuration": schema.StringAttribute{
+				MarkdownDescription: "Blueprint driver configuration in JSON format, GraphQL type [`BlueprintDriverConfigurationInput`](https://docs.zeet.co/graphql/inputs/blueprint-driver-configuration-input/)",
+				Optional:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			"rich_input_schema": schema.StringAttribute{
+				MarkdownDescription: "Blueprint rich input schema in [JSON format](https://anchor.zeet.co/static/schemas/blueprint-rich-input-schema.schema.json)",
+				Optional:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			"variables": schema.StringAttribute{
+				MarkdownDescription: "Blueprint variables, GraphQL type [`[BlueprintVariableSpecInput]`](https://docs.zeet.co/graphql/inputs/blueprint-variable-spec-input/)",
+				Optional:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *BlueprintResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeetClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// input returns the blueprint input of the model, the team and the type are only set on create.
+func (m *BlueprintResourceModel) input(ctx context.Context) (blueprintInput, error) {
+	input := blueprintInput{
+		Slug:        m.Slug.ValueString(),
+		DisplayName: m.DisplayName.ValueString(),
+		Published:   m.Published.ValueBool(),
+		Enabled:     lo.Ternary(m.Enabled.IsUnknown(), nil, m.Enabled.ValueBoolPointer()),
+		Description: m.Description.ValueStringPointer(),
+		Tags:        []string{},
+		Variables:   []blueprintVariableSpec{},
+	}
+
+	if !m.Tags.IsNull() {
+		if diags := m.Tags.ElementsAs(ctx, &input.Tags, false); diags.HasError() {
+			return input, fmt.Errorf("unable to read tags")
+		}
+	}
+
+	if !m.DriverConfiguration.IsNull() {
+		input.DriverConfiguration = json.RawMessage(m.DriverConfiguration.ValueString())
+	}
+
+	if !m.RichInputSchema.IsNull() {
+		input.RichInputSchema = json.RawMessage(m.RichInputSchema.ValueString())
+	}
+
+	if !m.Variables.IsNull() {
+		if err := json.Unmarshal([]byte(m.Variables.ValueString()), &input.Variables); err != nil {
+			return input, fmt.Errorf("unable to unmarshal variables, got error: %w", err)
+		}
+	}
+
+	return input, nil
+}
+
+func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BlueprintResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := data.input(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to create blueprint, got error: %s", err))
+		return
+	}
+	input.TeamId = lo.ToPtr(data.TeamId.ValueUUID())
+	input.Type = zeetv1.BlueprintType(data.Type.ValueString())
+
+	id, err := createBlueprintMutation(ctx, r.client.ClientV1(), input)
+	if err != nil {
+		addClientAttributeError(&resp.Diagnostics, "create blueprint", err, blueprintInputFields)
+		return
+	}
+
+	data.Id = customtypes.NewUUIDValue(id)
+
+	result, err := zeetv1.BlueprintByIdQuery(ctx, r.client.ClientV1(), id)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read blueprint", err)
+		return
+	}
+	if result.Blueprint != nil {
+		data.Enabled = types.BoolPointerValue(result.Blueprint.Enabled)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BlueprintResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := zeetv1.BlueprintByIdQuery(ctx, r.client.ClientV1(), data.Id.ValueUUID())
+	if apierrors.IsNotFound(err) || (err == nil && result.Blueprint == nil) {
+		tflog.Warn(ctx, "Blueprint not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read blueprint", err)
+		return
+	}
+
+	configuration, err := blueprintConfigurationQuery(ctx, r.client.ClientV1(), data.Id.ValueUUID())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read blueprint", err)
+		return
+	}
+
+	blueprint := result.Blueprint
+	data.Type = types.StringValue(string(blueprint.Type))
+	data.Slug = types.StringValue(blueprint.Configuration.Slug)
+	data.DisplayName = types.StringValue(blueprint.Configuration.DisplayName)
+	data.Description = types.StringPointerValue(blueprint.Configuration.Description)
+	data.Enabled = types.BoolPointerValue(blueprint.Enabled)
+	data.Published = types.BoolValue(blueprint.Configuration.Published)
+
+	// an empty list is the same as no tags
+	if len(blueprint.Configuration.Tags) > 0 || !data.Tags.IsNull() {
+		tags, diags := types.ListValueFrom(ctx, types.StringType, lo.Ternary(blueprint.Configuration.Tags != nil, blueprint.Configuration.Tags, []string{}))
+		resp.Diagnostics.Append(diags...)
+		data.Tags = tags
+	}
+
+	data.RichInputSchema = jsontypes.NewNormalizedPointerValue(blueprint.Configuration.RichInputSchema)
+
+	if configuration.DriverConfiguration != "" {
+		data.DriverConfiguration = jsontypes.NewNormalizedValue(configuration.DriverConfiguration)
+	} else {
+		data.DriverConfiguration = jsontypes.NewNormalizedNull()
+	}
+
+	// an empty list is the same as no variables
+	if len(configuration.Variables) > 0 || !data.Variables.IsNull() {
+		variables, err := json.Marshal(configuration.Variables)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read blueprint, got error: %s", err))
+			return
+		}
+		data.Variables = jsontypes.NewNormalizedValue(string(variables))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BlueprintResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := data.input(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to update blueprint, got error: %s", err))
+		return
+	}
+
+	if err := updateBlueprintMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID(), input); err != nil {
+		addClientAttributeError(&resp.Diagnostics, "update blueprint", err, blueprintInputFields)
+		return
+	}
+
+	result, err := zeetv1.BlueprintByIdQuery(ctx, r.client.ClientV1(), data.Id.ValueUUID())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read blueprint", err)
+		return
+	}
+	if result.Blueprint != nil {
+		data.Enabled = types.BoolPointerValue(result.Blueprint.Enabled)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BlueprintResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteBlueprintMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Client Error", "Blueprint not found, assuming it has been deleted")
+		} else {
+			addClientError(&resp.Diagnostics, "delete blueprint", err)
+			return
+		}
+	}
+}
+
+func (r *BlueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportId(req.ID, "team/blueprint")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import blueprint, got error: %s", err))
+		return
+	}
+	teamId, err := resolveTeamId(ctx, r.client, parts[0])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import blueprint", err)
+		return
+	}
+	blueprintId, err := resolveBlueprintId(ctx, r.client, teamId, parts[1])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import blueprint", err)
+		return
+	}
+
+	// official blueprints are managed by Zeet, they are only enabled or disabled for a team
+	result, err := zeetv1.BlueprintByIdQuery(ctx, r.client.ClientV1(), blueprintId)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import blueprint", err)
+		return
+	}
+	if result.Blueprint != nil && lo.FromPtr(result.Blueprint.IsOfficial) {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import blueprint, got error: blueprint %q is official, only custom blueprints can be imported", parts[1]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), customtypes.NewUUIDValue(teamId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), customtypes.NewUUIDValue(blueprintId))...)
+}
@@ -0,0 +1,196 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlueprintResource(t *testing.T) {
+	// the blueprint input of the last create or update, nil once deleted
+	var blueprint map[string]any
+	// an official blueprint listed with the team blueprints
+	officialBlueprintId := "6ab1e3b4-1c4b-4ac8-9cde-2b7f1f0f2c59"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			OperationName string         `json:"operationName"`
+			Variables     map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		switch req.OperationName {
+		case "createBlueprint":
+			blueprint = req.Variables["input"].(map[string]any)
+			if _, ok := blueprint["richInputSchema"].(map[string]any); !ok {
+				t.Fatalf("unexpected rich input schema %#v", blueprint["richInputSchema"])
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"createBlueprint": map[string]any{"id": testBlueprintId}},
+			})
+		case "updateBlueprint":
+			input := req.Variables["input"].(map[string]any)
+			input["type"] = blueprint["type"]
+			blueprint = input
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"updateBlueprint": map[string]any{"id": testBlueprintId}},
+			})
+		case "deleteBlueprint":
+			blueprint = nil
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"deleteBlueprint": true},
+			})
+		case "blueprintById":
+			if req.Variables["blueprintId"] == officialBlueprintId {
+				json.NewEncoder(w).Encode(map[string]any{
+					"data": map[string]any{
+						"blueprint": map[string]any{
+							"id":            officialBlueprintId,
+							"type":          "HELM",
+							"isOfficial":    true,
+							"enabled":       true,
+							"configuration": map[string]any{"slug": "helm-chart", "displayName": "Helm Chart", "published": true},
+						},
+					},
+				})
+				return
+			}
+			// the JSON scalars are returned encoded
+			richInputSchema, _ := json.Marshal(blueprint["richInputSchema"])
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"blueprint": map[string]any{
+						"id":         testBlueprintId,
+						"type":       blueprint["type"],
+						"isOfficial": false,
+						"enabled":    true,
+						"configuration": map[string]any{
+							"slug":            blueprint["slug"],
+							"displayName":     blueprint["displayName"],
+							"published":       blueprint["published"],
+							"description":     blueprint["description"],
+							"tags":            blueprint["tags"],
+							"richInputSchema": string(richInputSchema),
+						},
+					},
+				},
+			})
+		case "blueprintConfiguration":
+			driverConfiguration := map[string]any{
+				"__typename": "BlueprintHelmConfiguration",
+				"source":     map[string]any{"git": nil, "terraformModule": nil, "containerRegistry": nil},
+			}
+			for k, v := range blueprint["driverConfiguration"].(map[string]any)["helm"].(map[string]any)["source"].(map[string]any) {
+				driverConfiguration["source"].(map[string]any)[k] = v
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"blueprint": map[string]any{
+						"id": testBlueprintId,
+						"configuration": map[string]any{
+							"driverConfiguration": driverConfiguration,
+							"variables":           blueprint["variables"],
+						},
+					},
+				},
+			})
+		case "blueprints":
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"currentUser": map[string]any{"id": testTeamId},
+					"user": map[string]any{
+						"id": testTeamId,
+						"blueprints": map[string]any{
+							"nodes": []any{
+								map[string]any{"id": testBlueprintId, "slug": blueprint["slug"], "type": blueprint["type"], "isOfficial": false},
+								map[string]any{"id": officialBlueprintId, "slug": "helm-chart", "type": "HELM", "isOfficial": true},
+							},
+							"pageInfo": map[string]any{"hasNextPage": false},
+						},
+					},
+				},
+			})
+		default:
+			t.Fatalf("unexpected request %s", req.OperationName)
+		}
+	}))
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBlueprintResourceConfig(server.URL, "Grafana", `[{ name: "namespace", type: "STRING", required: true }]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_blueprint.test", "id", testBlueprintId.String()),
+					resource.TestCheckResourceAttr("zeet_blueprint.test", "display_name", "Grafana"),
+					resource.TestCheckResourceAttr("zeet_blueprint.test", "published", "true"),
+					resource.TestCheckResourceAttr("zeet_blueprint.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("zeet_blueprint.test", "tags.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_blueprint.test",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/grafana",
+				ImportStateVerify: true,
+			},
+			// Official blueprints can't be imported
+			{
+				ResourceName:  "zeet_blueprint.test",
+				ImportState:   true,
+				ImportStateId: testTeamId.String() + "/helm-chart",
+				ExpectError:   regexp.MustCompile("only custom blueprints can be imported"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccBlueprintResourceConfig(server.URL, "Grafana Dashboards", `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_blueprint.test", "display_name", "Grafana Dashboards"),
+					resource.TestCheckResourceAttr("zeet_blueprint.test", "variables", "[]"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBlueprintResourceConfig(server string, displayName string, variables string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_blueprint" "test" {
+  team_id = %[2]q
+  type    = "HELM"
+
+  slug         = "grafana"
+  display_name = %[3]q
+  description  = "Grafana chart"
+  tags         = ["helm", "grafana"]
+
+  driver_configuration = jsonencode({
+    helm = {
+      source = {
+        helmRepository = {
+          repositoryUrl = "https://grafana.github.io/helm-charts"
+          chart         = "grafana"
+        }
+      }
+    }
+  })
+  rich_input_schema = jsonencode({
+    namespace = { title = "Namespace" }
+  })
+  variables = jsonencode(%[4]s)
+}
+`, server, testTeamId.String(), displayName, variables)
+}
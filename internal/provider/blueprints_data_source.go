@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
+)
+
+// blueprintsPageSize is the number of blueprints fetched per request.
+const blueprintsPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BlueprintsDataSource{}
+
+func NewBlueprintsDataSource() datasource.DataSource {
+	return &BlueprintsDataSource{}
+}
+
+// BlueprintsDataSource defines the data source implementation.
+type BlueprintsDataSource struct {
+	client *zeetClient
+}
+
+// BlueprintsDataSourceModel describes the data source data model.
+type BlueprintsDataSourceModel struct {
+	TeamId     customtypes.UUIDValue `tfsdk:"team_id"`
+	Type       types.String          `tfsdk:"type"`
+	Tags       []types.String        `tfsdk:"tags"`
+	Published  types.Bool            `tfsdk:"published"`
+	Enabled    types.Bool            `tfsdk:"enabled"`
+	IsOfficial types.Bool            `tfsdk:"is_official"`
+
+	Blueprints []BlueprintsItemModel `tfsdk:"blueprints"`
+}
+
+type BlueprintsItemModel struct {
+	Id          customtypes.UUIDValue `tfsdk:"id"`
+	Slug        types.String          `tfsdk:"slug"`
+	DisplayName types.String          `tfsdk:"display_name"`
+	Description types.String          `tfsdk:"description"`
+	Type        types.String          `tfsdk:"type"`
+	Tags        []types.String        `tfsdk:"tags"`
+	IsOfficial  types.Bool            `tfsdk:"is_official"`
+	Published   types.Bool            `tfsdk:"published"`
+	Enabled     types.Bool            `tfsdk:"enabled"`
+}
+
+func (d *BlueprintsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprints"
+}
+
+func (d *BlueprintsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Blueprints data source, lists the official blueprints and the custom blueprints of a team matching all the filters",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team identifier, the custom blueprints of the team are listed when set",
+				Optional:            true,
+				CustomType:          customtypes.UUIDType{},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the blueprints of this [type](https://docs.zeet.co/graphql/enums/blueprint-type/)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(zeetv1.BlueprintTypeTerraform),
+						string(zeetv1.BlueprintTypeKubernetesManifest),
+						string(zeetv1.BlueprintTypeHelm),
+						string(zeetv1.BlueprintTypeAwsSam),
+						string(zeetv1.BlueprintTypeGcpCloudRun),
+						string(zeetv1.BlueprintTypeZeetKubernetes),
+						string(zeetv1.BlueprintTypeZeetAwsLambda),
+						string(zeetv1.BlueprintTypeZeetGcpCloudRun),
+					),
+				},
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Only list the blueprints having all these tags",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Only list the published or unpublished blueprints",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only list the enabled or disabled blueprints",
+				Optional:            true,
+			},
+			"is_official": schema.BoolAttribute{
+				MarkdownDescription: "Only list the official or custom blueprints",
+				Optional:            true,
+			},
+			"blueprints": schema.ListNestedAttribute{
+				MarkdownDescription: "Blueprints matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Blueprint identifier",
+							Computed:            true,
+							CustomType:          customtypes.UUIDType{},
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "Blueprint slug",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Blueprint display name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Blueprint description",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Blueprint type",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "List of tags associated with the blueprint",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"is_official": schema.BoolAttribute{
+							MarkdownDescription: "Blueprint is official",
+							Computed:            true,
+						},
+						"published": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the blueprint is published",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the blueprint is enabled",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BlueprintsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeetClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BlueprintsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BlueprintsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var blueprints []zeetv0.BlueprintList
+
+	// custom blueprints of the team, listed first as they include the official blueprints with their team settings
+	if !data.TeamId.IsNull() {
+		teamBlueprints, err := listTeamBlueprints(ctx, d.client, data.TeamId.ValueUUID())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "list blueprints", err)
+			return
+		}
+		blueprints = append(blueprints, teamBlueprints...)
+	}
+
+	// official blueprints
+	marketplace, err := listMarketplaceBlueprints(ctx, d.client)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list blueprints", err)
+		return
+	}
+	blueprints = append(blueprints, marketplace...)
+
+	blueprints = lo.UniqBy(blueprints, func(b zeetv0.BlueprintList) uuid.UUID {
+		return b.Id
+	})
+
+	data.Blueprints = []BlueprintsItemModel{}
+	for _, blueprint := range blueprints {
+		if !data.matches(blueprint) {
+			continue
+		}
+		data.Blueprints = append(data.Blueprints, BlueprintsItemModel{
+			Id:          customtypes.NewUUIDValue(blueprint.Id),
+			Slug:        types.StringValue(blueprint.Slug),
+			DisplayName: types.StringValue(blueprint.DisplayName),
+			Description: types.StringPointerValue(blueprint.Description),
+			Type:        types.StringValue(string(blueprint.Type)),
+			Tags:        lo.Map(blueprint.Tags, func(tag string, _ int) types.String { return types.StringValue(tag) }),
+			IsOfficial:  types.BoolValue(lo.FromPtr(blueprint.IsOfficial)),
+			Published:   types.BoolValue(blueprint.Published),
+			Enabled:     types.BoolPointerValue(blueprint.Enabled),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matches reports whether the blueprint matches all the filters of the data source.
+func (m *BlueprintsDataSourceModel) matches(blueprint zeetv0.BlueprintList) bool {
+	if !m.Type.IsNull() && string(blueprint.Type) != m.Type.ValueString() {
+		return false
+	}
+	for _, tag := range m.Tags {
+		if !lo.Contains(blueprint.Tags, tag.ValueString()) {
+			return false
+		}
+	}
+	if !m.Published.IsNull() && blueprint.Published != m.Published.ValueBool() {
+		return false
+	}
+	if !m.Enabled.IsNull() && lo.FromPtr(blueprint.Enabled) != m.Enabled.ValueBool() {
+		return false
+	}
+	if !m.IsOfficial.IsNull() && lo.FromPtr(blueprint.IsOfficial) != m.IsOfficial.ValueBool() {
+		return false
+	}
+	return true
+}
+
+// listTeamBlueprints returns all the blueprints of the team catalog.
+func listTeamBlueprints(ctx context.Context, client *zeetClient, teamId uuid.UUID) ([]zeetv0.BlueprintList, error) {
+	var blueprints []zeetv0.BlueprintList
+	page := zeetv0.PageInput{First: lo.ToPtr(blueprintsPageSize)}
+	for {
+		result, err := zeetv0.BlueprintsQuery(ctx, client.Client(), teamId.String(), teamId, page)
+		if err != nil {
+			return nil, err
+		}
+		if result.User.Blueprints == nil {
+			return blueprints, nil
+		}
+		for _, node := range result.User.Blueprints.Nodes {
+			blueprints = append(blueprints, node.BlueprintList)
+		}
+		if !result.User.Blueprints.PageInfo.HasNextPage {
+			return blueprints, nil
+		}
+		page.After = lo.ToPtr(result.User.Blueprints.PageInfo.EndCursor)
+	}
+}
+
+// The marketplaceBlueprints query of the SDK does not select the page info of the blueprints.
+const marketplaceBlueprintsPageOperation = `
+query marketplaceBlueprintsPage ($pageInput: PageInput!) {
+	blueprintsMarketplace {
+		blueprints(page: $pageInput) {
+			nodes {
+				... BlueprintList
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment BlueprintList on Blueprint {
+	id
+	description
+	displayName
+	isOfficial
+	logoUrl
+	enabled
+	published
+	projectCount
+	richInputSchema
+	allowedCloudProviders
+	slug
+	tags
+	type
+	configuration {
+		__typename
+		... ProjectBlueprintConfigurationDetail
+	}
+	owner {
+		name
+		login
+	}
+	variables {
+		id
+		name
+		type
+		required
+	}
+}
+fragment ProjectBlueprintConfigurationDetail on BlueprintConfiguration {
+	... on BlueprintTerraformConfiguration {
+		registrySource
+		moduleVersionConstraint
+		githubSource
+		moduleSource {
+			source
+			version
+			integration {
+				git {
+					githubInstallationID
+					bitbucketIntegrationID
+					gitlabIntegrationID
+				}
+			}
+		}
+	}
+	... on BlueprintHelmConfiguration {
+		source {
+			git {
+				repository
+				path
+				ref
+				integration {
+					githubInstallationID
+					bitbucketIntegrationID
+					gitlabIntegrationID
+				}
+			}
+			helmRepository {
+				chart
+				version
+				repositoryURL
+			}
+			containerRegistry {
+				registryID
+				registryURL
+				repository
+				tag
+			}
+		}
+	}
+	... on BlueprintManifestConfiguration {
+		source {
+			git {
+				repository
+				path
+				ref
+				integration {
+					githubInstallationID
+					bitbucketIntegrationID
+					gitlabIntegrationID
+				}
+			}
+		}
+	}
+}
+`
+
+// listMarketplaceBlueprints returns all the official blueprints of the marketplace.
+func listMarketplaceBlueprints(ctx context.Context, client *zeetClient) ([]zeetv0.BlueprintList, error) {
+	var blueprints []zeetv0.BlueprintList
+	page := zeetv0.PageInput{First: lo.ToPtr(blueprintsPageSize)}
+	for {
+		var data struct {
+			BlueprintsMarketplace *struct {
+				Blueprints *struct {
+					Nodes    []zeetv0.BlueprintList                                     `json:"nodes"`
+					PageInfo zeetv0.BlueprintsUserBlueprintsBlueprintConnectionPageInfo `json:"pageInfo"`
+				} `json:"blueprints"`
+			} `json:"blueprintsMarketplace"`
+		}
+		err := client.Client().MakeRequest(ctx, &graphql.Request{
+			OpName: "marketplaceBlueprintsPage",
+			Query:  marketplaceBlueprintsPageOperation,
+			Variables: map[string]any{
+				"pageInput": page,
+			},
+		}, &graphql.Response{Data: &data})
+		if err != nil {
+			return nil, err
+		}
+		if data.BlueprintsMarketplace == nil || data.BlueprintsMarketplace.Blueprints == nil {
+			return blueprints, nil
+		}
+		blueprints = append(blueprints, data.BlueprintsMarketplace.Blueprints.Nodes...)
+		if !data.BlueprintsMarketplace.Blueprints.PageInfo.HasNextPage {
+			return blueprints, nil
+		}
+		page.After = lo.ToPtr(data.BlueprintsMarketplace.Blueprints.PageInfo.EndCursor)
+	}
+}
+
+// findBlueprintBySlug returns the blueprint with the slug, a custom blueprint takes precedence over an official
+// blueprint with the same slug.
+func findBlueprintBySlug(blueprints []zeetv0.BlueprintList, slug string) (zeetv0.BlueprintList, bool) {
+	matches := lo.Filter(blueprints, func(b zeetv0.BlueprintList, _ int) bool {
+		return b.Slug == slug
+	})
+	if blueprint, ok := lo.Find(matches, func(b zeetv0.BlueprintList) bool {
+		return !lo.FromPtr(b.IsOfficial)
+	}); ok {
+		return blueprint, true
+	}
+	if len(matches) == 0 {
+		return zeetv0.BlueprintList{}, false
+	}
+	return matches[0], true
+}
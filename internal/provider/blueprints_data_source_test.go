@@ -0,0 +1,126 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlueprintsDataSource(t *testing.T) {
+	official := map[string]any{
+		"id": "6ab1e3b4-1c4b-4ac8-9cde-2b7f1f0f2c59", "slug": "helm-chart", "displayName": "Helm Chart",
+		"type": "HELM", "isOfficial": true, "enabled": false, "published": true, "tags": []string{"helm"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reqs := string(req)
+		if strings.Contains(reqs, "query marketplaceBlueprintsPage") {
+			// the official blueprints are returned in two pages
+			page := map[string]any{"nodes": []any{official}, "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "1"}}
+			if strings.Contains(reqs, `"after":"1"`) {
+				page = map[string]any{"nodes": []any{
+					map[string]any{
+						"id": "0f9ab2cc-3f1f-4e1e-b1f0-8c7b2d0c8a11", "slug": "terraform-module", "displayName": "Terraform Module",
+						"type": "TERRAFORM", "isOfficial": true, "enabled": true, "published": true, "tags": []string{"terraform"},
+					},
+				}, "pageInfo": map[string]any{"hasNextPage": false}}
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"blueprintsMarketplace": map[string]any{
+						"blueprints": page,
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query blueprints (") {
+			// the team blueprints are returned in two pages
+			page := map[string]any{"nodes": []any{}, "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "1"}}
+			if strings.Contains(reqs, `"after":"1"`) {
+				page = map[string]any{"nodes": []any{
+					// the official blueprint enabled for the team
+					map[string]any{
+						"id": official["id"], "slug": "helm-chart", "displayName": "Helm Chart",
+						"type": "HELM", "isOfficial": true, "enabled": true, "published": true, "tags": []string{"helm"},
+					},
+					map[string]any{
+						"id": testBlueprintId, "slug": "route53-delegation", "displayName": "route53 delegation",
+						"type": "TERRAFORM", "isOfficial": false, "enabled": true, "published": true, "tags": []string{"route53", "delegation"},
+					},
+				}, "pageInfo": map[string]any{"hasNextPage": false}}
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"currentUser": map[string]any{"id": "99c11487-1683-4e10-9620-94d9a78a0b67"},
+					"user": map[string]any{
+						"id":         "99c11487-1683-4e10-9620-94d9a78a0b67",
+						"blueprints": page,
+					},
+				},
+			})
+		} else {
+			t.Fatal("unexpected request")
+		}
+	}))
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Official blueprints only
+			{
+				Config: testAccBlueprintsDataSourceConfig(server.URL, ``),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.#", "2"),
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.0.slug", "helm-chart"),
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.0.enabled", "false"),
+				),
+			},
+			// Team blueprints, official blueprints take the team settings
+			{
+				Config: testAccBlueprintsDataSourceConfig(server.URL, `team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.#", "3"),
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.0.slug", "helm-chart"),
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.0.enabled", "true"),
+				),
+			},
+			// Filters
+			{
+				Config: testAccBlueprintsDataSourceConfig(server.URL, `
+  team_id     = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  type        = "TERRAFORM"
+  tags        = ["route53"]
+  enabled     = true
+  published   = true
+  is_official = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.#", "1"),
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.0.id", testBlueprintId.String()),
+					resource.TestCheckResourceAttr("data.zeet_blueprints.test", "blueprints.0.tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlueprintsDataSourceConfig(server string, filters string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+data "zeet_blueprints" "test" {
+  %[2]s
+}
+`, server, filters)
+}
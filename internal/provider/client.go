@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// zeetClient is the Zeet API client shared by data sources and resources, it mirrors api.Client from the
+// Zeet CLI but lets the provider configure the HTTP transport.
+type zeetClient struct {
+	gql   graphql.Client
+	gqlV1 graphql.Client
+}
+
+func newZeetClient(server, token, version string, transport http.RoundTripper) *zeetClient {
+	httpClient := &http.Client{
+		Transport: &authTransport{
+			token:     token,
+			userAgent: fmt.Sprintf("zeet-cli/%s", version),
+			next:      transport,
+		},
+	}
+
+	server = strings.TrimSuffix(server, "/")
+	return &zeetClient{
+		gql:   graphql.NewClient(server+"/graphql", httpClient),
+		gqlV1: graphql.NewClient(server+"/v1/graphql", httpClient),
+	}
+}
+
+// Client returns the v0 GraphQL client.
+func (c *zeetClient) Client() graphql.Client {
+	return c.gql
+}
+
+// ClientV1 returns the v1 GraphQL client.
+func (c *zeetClient) ClientV1() graphql.Client {
+	return c.gqlV1
+}
+
+// authTransport sets the user agent and bearer token on every request.
+type authTransport struct {
+	token     string
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	return t.next.RoundTrip(req)
+}
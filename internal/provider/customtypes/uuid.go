@@ -99,6 +99,12 @@ func NewUUIDValue(value uuid.UUID) UUIDValue {
 	}
 }
 
+func NewUUIDNull() UUIDValue {
+	return UUIDValue{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
 // UUIDValue is a custom value used to validate that a string is a UUID.
 type UUIDValue struct {
 	basetypes.StringValue
@@ -119,6 +125,15 @@ func (v UUIDValue) ValueUUID() uuid.UUID {
 	return u
 }
 
+func (v UUIDValue) ValueUUIDPointer() *uuid.UUID {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	u := v.ValueUUID()
+	return &u
+}
+
 func (v UUIDValue) Equal(o attr.Value) bool {
 	other, ok := o.(UUIDValue)
 	if !ok {
@@ -0,0 +1,158 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = &YAMLType{}
+var _ xattr.TypeWithValidate = YAMLType{}
+
+func (t YAMLType) String() string {
+	return "YAMLType"
+}
+
+func (t YAMLType) ValueType(context.Context) attr.Value {
+	return YAMLValue{}
+}
+
+func (t YAMLType) Equal(o attr.Type) bool {
+	other, ok := o.(YAMLType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t YAMLType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return YAMLValue{
+		StringValue: in,
+	}, nil
+}
+
+func (t YAMLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	yamlValue, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to YAMLValue: %v", diags)
+	}
+
+	return yamlValue, nil
+}
+
+// Validate checks that the value is a valid YAML document, if it is known and not null.
+func (t YAMLType) Validate(ctx context.Context, value tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	var valueString string
+	if err := value.As(&valueString); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"expected a string",
+			err.Error(),
+		)
+		return diags
+	}
+
+	var document any
+	if err := yaml.Unmarshal([]byte(valueString), &document); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"expected a valid YAML document",
+			err.Error(),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func NewYAMLValue(value string) YAMLValue {
+	return YAMLValue{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+func NewYAMLPointerValue(value *string) YAMLValue {
+	return YAMLValue{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+func NewYAMLNull() YAMLValue {
+	return YAMLValue{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// YAMLValue is a custom value used to validate that a string is a YAML document, two documents are semantically
+// equal when they decode to the same value regardless of their formatting, comments and key order.
+type YAMLValue struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = YAMLValue{}
+
+func (v YAMLValue) Type(context.Context) attr.Type {
+	return YAMLType{}
+}
+
+func (v YAMLValue) Equal(o attr.Value) bool {
+	other, ok := o.(YAMLValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v YAMLValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(YAMLValue)
+	if !ok {
+		diags.AddError("expected new value to be a YAMLValue", "")
+		return false, diags
+	}
+
+	var oldDocument, newDocument any
+	if err := yaml.Unmarshal([]byte(v.ValueString()), &oldDocument); err != nil {
+		diags.AddError("expected old value to be a valid YAML document", err.Error())
+	}
+	if err := yaml.Unmarshal([]byte(newValue.ValueString()), &newDocument); err != nil {
+		diags.AddError("expected new value to be a valid YAML document", err.Error())
+	}
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(oldDocument, newDocument), diags
+}
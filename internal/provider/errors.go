@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
+)
+
+// addClientError reports a failed API call, action describes what was attempted e.g. "create group".
+func addClientError(diags *diag.Diagnostics, action string, err error) {
+	addClientAttributeError(diags, action, err, nil)
+}
+
+// addClientAttributeError is addClientError for calls sending user input, validation errors on an API input field
+// listed in fields are reported on the matching attribute.
+func addClientAttributeError(diags *diag.Diagnostics, action string, err error, fields map[string]path.Path) {
+	apiErr := apierrors.Classify(err)
+	detail := fmt.Sprintf("Unable to %s, got error: %s", action, err)
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		diags.AddError("Timeout", detail+"\n\nIncrease the timeouts of the resource if the operation needs more time.")
+		return
+	}
+
+	switch apiErr.Kind {
+	case apierrors.KindUnauthorized:
+		diags.AddError("Unauthorized", detail+"\n\nCheck that the provider api_token is valid.")
+	case apierrors.KindForbidden:
+		diags.AddError("Forbidden", detail+"\n\nCheck that the provider api_token has access to this team.")
+	case apierrors.KindValidation:
+		if len(apiErr.Path) > 0 {
+			if attr, ok := fields[apiErr.Path[len(apiErr.Path)-1]]; ok {
+				diags.AddAttributeError(attr, "Invalid Attribute Value", detail)
+				return
+			}
+			detail = fmt.Sprintf("Unable to %s, invalid value for %s, got error: %s", action, strings.Join(apiErr.Path, "."), err)
+		}
+		diags.AddError("Client Error", detail)
+	default:
+		diags.AddError("Client Error", detail)
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/zeet-dev/cli/pkg/api"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
 	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
 )
@@ -23,7 +22,7 @@ func NewGroupDataSource() datasource.DataSource {
 
 // GroupDataSource defines the data source implementation.
 type GroupDataSource struct {
-	client *api.Client
+	client *zeetClient
 }
 
 // GroupDataSourceModel describes the data source data model.
@@ -66,12 +65,12 @@ func (d *GroupDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*zeetClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -92,7 +91,7 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	result, err := zeetv1.GroupQuery(ctx, d.client.ClientV1(), data.TeamId.ValueUUID(), data.Id.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read group", err)
 		return
 	}
 
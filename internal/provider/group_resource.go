@@ -3,8 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,8 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/samber/lo"
 
-	"github.com/zeet-dev/cli/pkg/api"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
 	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
 )
 
@@ -29,7 +29,7 @@ func NewGroupResource() resource.Resource {
 
 // GroupResource defines the resource implementation.
 type GroupResource struct {
-	client *api.Client
+	client *zeetClient
 }
 
 // GroupResourceModel describes the resource data model.
@@ -37,6 +37,8 @@ type GroupResourceModel struct {
 	Id     customtypes.UUIDValue `tfsdk:"id"`
 	TeamId customtypes.UUIDValue `tfsdk:"team_id"`
 	Name   types.String          `tfsdk:"name"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,7 +48,7 @@ func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataReque
 func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Group resource",
+		MarkdownDescription: "Group resource\n\nImport with an identifier in the format `team/group`, each part being a name or an identifier.",
 
 		Attributes: map[string]schema.Attribute{
 			"team_id": schema.StringAttribute{
@@ -70,6 +72,9 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -79,12 +84,12 @@ func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*zeetClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -103,12 +108,18 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := zeetv1.CreateGroupMutation(ctx, r.client.ClientV1(), zeetv1.CreateGroupInput{
 		TeamId: data.TeamId.ValueUUID(),
 		Name:   data.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group, got error: %s", err))
+		addClientAttributeError(&resp.Diagnostics, "create group", err, map[string]path.Path{"name": path.Root("name")})
 		return
 	}
 
@@ -131,12 +142,28 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := zeetv1.GroupQuery(ctx, r.client.ClientV1(), data.TeamId.ValueUUID(), data.Id.ValueUUID())
+	if apierrors.IsNotFound(err) {
+		tflog.Warn(ctx, "Group not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read group", err)
 		return
 	}
 
+	if result.Team == nil || len(result.Team.Groups.Nodes) == 0 {
+		tflog.Warn(ctx, "Group not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if len(result.Team.Groups.Nodes) == 1 {
 		data.Name = types.StringValue(result.Team.Groups.Nodes[0].Name)
 	}
@@ -155,11 +182,17 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := zeetv1.UpdateGroupMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID(), zeetv1.UpdateGroupInput{
 		Name: lo.ToPtr(data.Name.ValueString()),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group, got error: %s", err))
+		addClientAttributeError(&resp.Diagnostics, "update group", err, map[string]path.Path{"name": path.Root("name")})
 		return
 	}
 
@@ -179,17 +212,40 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := zeetv1.DeleteGroupMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID())
 	if err != nil {
-		if strings.Contains(err.Error(), "record not found") {
+		if apierrors.IsNotFound(err) {
 			resp.Diagnostics.AddWarning("Client Error", "Group not found, assuming it has been deleted")
 		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group, got error: %s", err))
+			addClientError(&resp.Diagnostics, "delete group", err)
 			return
 		}
 	}
 }
 
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parts, err := splitImportId(req.ID, "team/group")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import group, got error: %s", err))
+		return
+	}
+	teamId, err := resolveTeamId(ctx, r.client, parts[0])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import group", err)
+		return
+	}
+	groupId, _, err := resolveGroup(ctx, r.client, teamId, parts[1])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import group", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), customtypes.NewUUIDValue(teamId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), customtypes.NewUUIDValue(groupId))...)
 }
@@ -6,16 +6,19 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
+	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
 )
 
 func TestAccGroupResource(t *testing.T) {
-	var created bool
+	var created, deleted bool
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		req, err := io.ReadAll(r.Body)
 		if err != nil {
@@ -40,8 +43,22 @@ func TestAccGroupResource(t *testing.T) {
 					},
 				},
 			})
+		} else if strings.Contains(reqs, "query teamByName") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.TeamByNameResponse{
+					Team: &zeetv0.TeamByNameTeam{
+						Id: testTeamId,
+					},
+				},
+			})
 		} else if strings.Contains(reqs, "query group") {
-			if !created {
+			if deleted {
+				json.NewEncoder(w).Encode(map[string]any{
+					"data": zeetv1.GroupResponse{
+						Team: &zeetv1.GroupTeam{},
+					},
+				})
+			} else if !created {
 				json.NewEncoder(w).Encode(map[string]any{
 					"data": zeetv1.GroupResponse{
 						Team: &zeetv1.GroupTeam{
@@ -105,6 +122,27 @@ func TestAccGroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr("zeet_group.test", "name", "two"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_group.test",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/" + testGroupId.String(),
+				ImportStateVerify: true,
+			},
+			// ImportState by name testing
+			{
+				ResourceName:      "zeet_group.test",
+				ImportState:       true,
+				ImportStateId:     "zeet/two",
+				ImportStateVerify: true,
+			},
+			// Deleted outside of Terraform testing
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             testAccGroupResourceConfig(server.URL, "two"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -122,3 +160,37 @@ resource "zeet_group" "test" {
 }
 `, server, name)
 }
+
+func TestAccGroupResourceTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "zeet" {
+  api_url = %q
+}
+
+resource "zeet_group" "test" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  name    = "one"
+
+  timeouts {
+    create = "100ms"
+  }
+}
+`, server.URL),
+				ExpectError: regexp.MustCompile(`Timeout`),
+			},
+		},
+	})
+}
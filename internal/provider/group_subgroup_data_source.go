@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/zeet-dev/cli/pkg/api"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
 	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
 )
@@ -23,7 +22,7 @@ func NewGroupSubGroupDataSource() datasource.DataSource {
 
 // GroupSubGroupDataSource defines the data source implementation.
 type GroupSubGroupDataSource struct {
-	client *api.Client
+	client *zeetClient
 }
 
 // GroupSubGroupDataSourceModel describes the data source data model.
@@ -72,12 +71,12 @@ func (d *GroupSubGroupDataSource) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*zeetClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -98,7 +97,7 @@ func (d *GroupSubGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	result, err := zeetv1.SubGroupQuery(ctx, d.client.ClientV1(), data.TeamId.ValueUUID(), data.GroupId.ValueUUID(), data.Id.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groupsubgroup, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read groupsubgroup", err)
 		return
 	}
 
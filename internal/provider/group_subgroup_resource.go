@@ -3,8 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,8 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/samber/lo"
 
-	"github.com/zeet-dev/cli/pkg/api"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
 	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
 )
 
@@ -29,7 +30,7 @@ func NewGroupSubgroupResource() resource.Resource {
 
 // GroupSubgroupResource defines the resource implementation.
 type GroupSubgroupResource struct {
-	client *api.Client
+	client *zeetClient
 }
 
 // GroupSubgroupResourceModel describes the resource data model.
@@ -38,6 +39,8 @@ type GroupSubgroupResourceModel struct {
 	GroupId customtypes.UUIDValue `tfsdk:"group_id"`
 	TeamId  customtypes.UUIDValue `tfsdk:"team_id"`
 	Name    types.String          `tfsdk:"name"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *GroupSubgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,7 +50,7 @@ func (r *GroupSubgroupResource) Metadata(ctx context.Context, req resource.Metad
 func (r *GroupSubgroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Group Subgroup resource",
+		MarkdownDescription: "Group Subgroup resource\n\nImport with an identifier in the format `team/group/subgroup`, each part being a name or an identifier.",
 
 		Attributes: map[string]schema.Attribute{
 			"team_id": schema.StringAttribute{
@@ -79,6 +82,9 @@ func (r *GroupSubgroupResource) Schema(ctx context.Context, req resource.SchemaR
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -88,12 +94,12 @@ func (r *GroupSubgroupResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*zeetClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -112,12 +118,18 @@ func (r *GroupSubgroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := zeetv1.CreateSubGroupMutation(ctx, r.client.ClientV1(), zeetv1.CreateSubGroupInput{
 		GroupId: data.GroupId.ValueUUID(),
 		Name:    data.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subgroup, got error: %s", err))
+		addClientAttributeError(&resp.Diagnostics, "create subgroup", err, map[string]path.Path{"name": path.Root("name")})
 		return
 	}
 
@@ -140,12 +152,28 @@ func (r *GroupSubgroupResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := zeetv1.SubGroupQuery(ctx, r.client.ClientV1(), data.TeamId.ValueUUID(), data.GroupId.ValueUUID(), data.Id.ValueUUID())
+	if apierrors.IsNotFound(err) {
+		tflog.Warn(ctx, "Subgroup not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subgroup, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read subgroup", err)
 		return
 	}
 
+	if result.Team == nil || len(result.Team.Groups.Nodes) == 0 || result.Team.Groups.Nodes[0].SubGroup.Id == uuid.Nil {
+		tflog.Warn(ctx, "Subgroup not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if len(result.Team.Groups.Nodes) == 1 {
 		data.Name = types.StringValue(result.Team.Groups.Nodes[0].SubGroup.Name)
 	}
@@ -164,11 +192,17 @@ func (r *GroupSubgroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := zeetv1.UpdateSubGroupMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID(), zeetv1.UpdateSubGroupInput{
 		Name: lo.ToPtr(data.Name.ValueString()),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update subgroup, got error: %s", err))
+		addClientAttributeError(&resp.Diagnostics, "update subgroup", err, map[string]path.Path{"name": path.Root("name")})
 		return
 	}
 
@@ -188,17 +222,46 @@ func (r *GroupSubgroupResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := zeetv1.DeleteSubGroupMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID())
 	if err != nil {
-		if strings.Contains(err.Error(), "record not found") {
+		if apierrors.IsNotFound(err) {
 			resp.Diagnostics.AddWarning("Client Error", "Subgroup not found, assuming it has been deleted")
 		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subgroup, got error: %s", err))
+			addClientError(&resp.Diagnostics, "delete subgroup", err)
 			return
 		}
 	}
 }
 
 func (r *GroupSubgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parts, err := splitImportId(req.ID, "team/group/subgroup")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import subgroup, got error: %s", err))
+		return
+	}
+	teamId, err := resolveTeamId(ctx, r.client, parts[0])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import subgroup", err)
+		return
+	}
+	groupId, _, err := resolveGroup(ctx, r.client, teamId, parts[1])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import subgroup", err)
+		return
+	}
+	subGroupId, _, err := resolveSubGroup(ctx, r.client, teamId, groupId, parts[2])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import subgroup", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), customtypes.NewUUIDValue(teamId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), customtypes.NewUUIDValue(groupId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), customtypes.NewUUIDValue(subGroupId))...)
 }
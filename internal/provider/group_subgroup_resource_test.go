@@ -40,6 +40,41 @@ func TestAccGroupSubgroupResource(t *testing.T) {
 					},
 				},
 			})
+		} else if strings.Contains(reqs, "query groupSubGroups") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.GroupSubGroupsResponse{
+					Team: &zeetv1.GroupSubGroupsTeam{
+						Groups: zeetv1.GroupSubGroupsTeamGroupsGroupConnection{
+							Nodes: []zeetv1.GroupSubGroupsTeamGroupsGroupConnectionNodesGroup{
+								{
+									Id: testGroupId,
+									SubGroups: []zeetv1.GroupSubGroupsTeamGroupsGroupConnectionNodesGroupSubGroupsSubGroup{
+										{
+											Id:   testSubGroupId,
+											Name: "two",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query group") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.GroupResponse{
+					Team: &zeetv1.GroupTeam{
+						Groups: zeetv1.GroupTeamGroupsGroupConnection{
+							Nodes: []zeetv1.GroupTeamGroupsGroupConnectionNodesGroup{
+								{
+									Id:   testGroupId,
+									Name: "g",
+								},
+							},
+						},
+					},
+				},
+			})
 		} else if strings.Contains(reqs, "query subGroup") {
 			if !created {
 				json.NewEncoder(w).Encode(map[string]any{
@@ -112,6 +147,13 @@ func TestAccGroupSubgroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr("zeet_group_subgroup.test", "name", "two"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_group_subgroup.test",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/" + testGroupId.String() + "/two",
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+)
+
+// splitImportId splits a composite import identifier such as `team/group/subgroup`,
+// each part can be either a UUID or a name.
+func splitImportId(id string, format string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != len(strings.Split(format, "/")) || lo.Contains(parts, "") {
+		return nil, fmt.Errorf("expected import identifier with format: %s, got: %q", format, id)
+	}
+	return parts, nil
+}
+
+// resolveTeamId returns the team identifier from a UUID or a team name.
+func resolveTeamId(ctx context.Context, client *zeetClient, team string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(team); err == nil {
+		return id, nil
+	}
+
+	result, err := zeetv0.TeamByNameQuery(ctx, client.Client(), team)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if result.Team == nil {
+		return uuid.Nil, fmt.Errorf("team %q not found", team)
+	}
+	return result.Team.Id, nil
+}
+
+// resolveGroup returns the group identifier and name from a UUID or a group name.
+func resolveGroup(ctx context.Context, client *zeetClient, teamId uuid.UUID, group string) (uuid.UUID, string, error) {
+	if id, err := uuid.Parse(group); err == nil {
+		result, err := zeetv1.GroupQuery(ctx, client.ClientV1(), teamId, id)
+		if err != nil {
+			return uuid.Nil, "", err
+		}
+		if result.Team == nil || len(result.Team.Groups.Nodes) != 1 {
+			return uuid.Nil, "", fmt.Errorf("group %q not found", group)
+		}
+		return id, result.Team.Groups.Nodes[0].Name, nil
+	}
+
+	result, err := zeetv1.GroupsQuery(ctx, client.ClientV1(), teamId, zeetv1.GroupsInput{
+		Filter: &zeetv1.GroupFilter{
+			Name: &zeetv1.StringCriterion{
+				Value:    lo.ToPtr(group),
+				Operator: lo.ToPtr(zeetv1.FilterCriterionOperatorTypeEquals),
+			},
+		},
+	})
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	if result.Team == nil {
+		return uuid.Nil, "", fmt.Errorf("group %q not found", group)
+	}
+	node, ok := lo.Find(result.Team.Groups.Nodes, func(g zeetv1.GroupsTeamGroupsGroupConnectionNodesGroup) bool {
+		return g.Name == group
+	})
+	if !ok {
+		return uuid.Nil, "", fmt.Errorf("group %q not found", group)
+	}
+	return node.Id, node.Name, nil
+}
+
+// resolveSubGroup returns the subgroup identifier and name from a UUID or a subgroup name.
+func resolveSubGroup(ctx context.Context, client *zeetClient, teamId uuid.UUID, groupId uuid.UUID, subGroup string) (uuid.UUID, string, error) {
+	result, err := zeetv1.GroupSubGroupsQuery(ctx, client.ClientV1(), teamId, groupId)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	if result.Team == nil || len(result.Team.Groups.Nodes) != 1 {
+		return uuid.Nil, "", fmt.Errorf("group %q not found", groupId)
+	}
+	node, ok := lo.Find(result.Team.Groups.Nodes[0].SubGroups, func(s zeetv1.GroupSubGroupsTeamGroupsGroupConnectionNodesGroupSubGroupsSubGroup) bool {
+		return s.Id.String() == subGroup || s.Name == subGroup
+	})
+	if !ok {
+		return uuid.Nil, "", fmt.Errorf("subgroup %q not found", subGroup)
+	}
+	return node.Id, node.Name, nil
+}
+
+// resolveBlueprintId returns the blueprint identifier from a UUID or a blueprint slug of the team.
+func resolveBlueprintId(ctx context.Context, client *zeetClient, teamId uuid.UUID, blueprint string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(blueprint); err == nil {
+		return id, nil
+	}
+
+	blueprints, err := listTeamBlueprints(ctx, client, teamId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	node, ok := findBlueprintBySlug(blueprints, blueprint)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("blueprint %q not found", blueprint)
+	}
+	return node.Id, nil
+}
+
+// resolveProjectId returns the project identifier from a UUID or a project name within the group and subgroup.
+func resolveProjectId(ctx context.Context, client *zeetClient, teamId uuid.UUID, groupName string, subGroupName string, project string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(project); err == nil {
+		return id, nil
+	}
+
+	result, err := zeetv0.ProjectV3Query(ctx, client.Client(), teamId.String(), groupName, subGroupName, project)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if result.User.ProjectV3Adapters == nil || len(result.User.ProjectV3Adapters.Nodes) == 0 {
+		return uuid.Nil, fmt.Errorf("project %q not found", project)
+	}
+	return result.User.ProjectV3Adapters.Nodes[0].Id, nil
+}
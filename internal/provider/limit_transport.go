@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// limitTransport caps the rate and the number of in flight API requests, it is shared by all data sources and
+// resources of a provider instance.
+type limitTransport struct {
+	// limiter is nil when the request rate is unlimited
+	limiter *rate.Limiter
+	// slots is nil when the number of concurrent requests is unlimited
+	slots chan struct{}
+	next  http.RoundTripper
+}
+
+func newLimitTransport(requestsPerSecond float64, concurrentRequests int, next http.RoundTripper) *limitTransport {
+	t := &limitTransport{next: next}
+	if requestsPerSecond > 0 {
+		// allow a burst of one second worth of requests
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), max(int(requestsPerSecond), 1))
+	}
+	if concurrentRequests > 0 {
+		t.slots = make(chan struct{}, concurrentRequests)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := sync.OnceFunc(func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	})
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	// the request is in flight until its response is read
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseBody releases the concurrency slot of a request once its response body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
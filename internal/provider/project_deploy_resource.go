@@ -0,0 +1,443 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectDeployResource{}
+var _ resource.ResourceWithImportState = &ProjectDeployResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectDeployResource{}
+
+func NewProjectDeployResource() resource.Resource {
+	return &ProjectDeployResource{}
+}
+
+// ProjectDeployResource defines the resource implementation.
+type ProjectDeployResource struct {
+	client *zeetClient
+}
+
+// ProjectDeployResourceModel describes the resource data model, the deploy attributes are the ones of
+// ProjectDeployModel.
+type ProjectDeployResourceModel struct {
+	TeamId    customtypes.UUIDValue `tfsdk:"team_id"`
+	ProjectId customtypes.UUIDValue `tfsdk:"project_id"`
+	Name      types.String          `tfsdk:"name"`
+
+	Id                   customtypes.UUIDValue         `tfsdk:"id"`
+	DefaultWorkflowSteps []types.String                `tfsdk:"default_workflow_steps"`
+	RequirePlanApproval  types.Bool                    `tfsdk:"require_plan_approval"`
+	Variables            jsontypes.Normalized          `tfsdk:"variables"`
+	Kubernetes           jsontypes.Normalized          `tfsdk:"kubernetes"`
+	KubernetesConfig     *ProjectDeployKubernetesModel `tfsdk:"kubernetes_config"`
+	Helm                 jsontypes.Normalized          `tfsdk:"helm"`
+	HelmConfig           *ProjectDeployHelmModel       `tfsdk:"helm_config"`
+	Terraform            jsontypes.Normalized          `tfsdk:"terraform"`
+	TerraformConfig      *ProjectDeployTerraformModel  `tfsdk:"terraform_config"`
+
+	WaitForWorkflowRun types.Bool     `tfsdk:"wait_for_workflow_run"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// deploy returns the deploy attributes of the resource.
+func (m *ProjectDeployResourceModel) deploy() ProjectDeployModel {
+	return ProjectDeployModel{
+		Id:                   m.Id,
+		DefaultWorkflowSteps: m.DefaultWorkflowSteps,
+		RequirePlanApproval:  m.RequirePlanApproval,
+		Variables:            m.Variables,
+		Kubernetes:           m.Kubernetes,
+		KubernetesConfig:     m.KubernetesConfig,
+		Helm:                 m.Helm,
+		HelmConfig:           m.HelmConfig,
+		Terraform:            m.Terraform,
+		TerraformConfig:      m.TerraformConfig,
+	}
+}
+
+// setDeploy sets the deploy attributes of the resource.
+func (m *ProjectDeployResourceModel) setDeploy(d ProjectDeployModel) {
+	m.Id = d.Id
+	m.DefaultWorkflowSteps = d.DefaultWorkflowSteps
+	m.RequirePlanApproval = d.RequirePlanApproval
+	m.Variables = d.Variables
+	m.Kubernetes = d.Kubernetes
+	m.KubernetesConfig = d.KubernetesConfig
+	m.Helm = d.Helm
+	m.HelmConfig = d.HelmConfig
+	m.Terraform = d.Terraform
+	m.TerraformConfig = d.TerraformConfig
+}
+
+func (r *ProjectDeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_deploy"
+}
+
+func (r *ProjectDeployResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := projectDeployAttributes()
+	attributes["team_id"] = schema.StringAttribute{
+		MarkdownDescription: "Team identifier",
+		Required:            true,
+		CustomType:          customtypes.UUIDType{},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Workflow project identifier",
+		Required:            true,
+		CustomType:          customtypes.UUIDType{},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Deployment name, generated by Zeet",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["wait_for_workflow_run"] = schema.BoolAttribute{
+		MarkdownDescription: "Wait for the workflow run started by a create or update of the deploy to finish, " +
+			"failing the apply with the failed steps and their logs if it fails. The wait is bounded by the create and update timeouts.",
+		Optional: true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Project Deploy resource, a deploy of a workflow project managed on its own, for example to add a deploy to a " +
+			"project that is not managed by Terraform. The deploys of a `zeet_project` are not affected by this resource.\n\n" +
+			"Import with an identifier in the format `team/project_id/deploy`, the team being a name or an identifier and the deploy a name or an identifier.",
+
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ProjectDeployResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// a deploy has a single driver
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("helm"),
+			path.MatchRoot("helm_config"),
+			path.MatchRoot("kubernetes"),
+			path.MatchRoot("kubernetes_config"),
+			path.MatchRoot("terraform"),
+			path.MatchRoot("terraform_config"),
+		),
+	}
+}
+
+func (r *ProjectDeployResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeetClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectDeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectDeployResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploy := data.deploy()
+	input, diags := deploy.toInput()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousRun, err := r.latestRollout(ctx, &data)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read project deployments", err)
+		return
+	}
+
+	created, err := createDeployMutation(ctx, r.client.ClientV1(), createDeployInput{
+		ProjectId:     data.ProjectId.ValueUUID(),
+		Configuration: input,
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create deploy", err)
+		return
+	}
+
+	data.Id = customtypes.NewUUIDValue(created.Id)
+	data.Name = types.StringValue(created.Name)
+	if err := r.readComputed(ctx, &data); err != nil {
+		addClientError(&resp.Diagnostics, "read deploy", err)
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForRollout(ctx, &data, previousRun, true, &resp.Diagnostics)
+}
+
+func (r *ProjectDeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectDeployResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), data.TeamId.ValueUUID(), data.ProjectId.ValueUUID())
+	if apierrors.IsNotFound(err) || err == nil && (result.Team == nil || result.Team.Project == nil) {
+		tflog.Warn(ctx, "Project not found, removing the deploy from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read deploy", err)
+		return
+	}
+
+	node, ok := lo.Find(result.Team.Project.Deploys.Nodes, func(d zeetv1.ProjectDetailDeploysDeployConnectionNodesDeploy) bool {
+		return d.Id == data.Id.ValueUUID()
+	})
+	if !ok {
+		tflog.Warn(ctx, "Deploy not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	deploy := data.deploy()
+	resp.Diagnostics.Append(deploy.read(&node.DeployConfigurationDetail)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.setDeploy(deploy)
+	data.Name = types.StringValue(node.Name)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectDeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ProjectDeployResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploy := data.deploy()
+	input, diags := deploy.toInput()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prev := state.deploy()
+	prevInput, diags := prev.toInput()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousRun, err := r.latestRollout(ctx, &data)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read project deployments", err)
+		return
+	}
+
+	if _, err := zeetv1.UpdateDeployMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID(), zeetv1.UpdateDeployInput{
+		Configuration: input,
+	}); err != nil {
+		addClientError(&resp.Diagnostics, "update deploy", err)
+		return
+	}
+	if err := r.readComputed(ctx, &data); err != nil {
+		addClientError(&resp.Diagnostics, "read deploy", err)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// changing only the wait setting or the timeouts doesn't start a workflow run
+	r.waitForRollout(ctx, &data, previousRun, !reflect.DeepEqual(input, prevInput), &resp.Diagnostics)
+}
+
+func (r *ProjectDeployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectDeployResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteDeployMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID()); err != nil {
+		if apierrors.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Client Error", "Deploy not found, assuming it has been deleted")
+		} else {
+			addClientError(&resp.Diagnostics, "delete deploy", err)
+			return
+		}
+	}
+}
+
+func (r *ProjectDeployResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportId(req.ID, "team/project_id/deploy")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import deploy, got error: %s", err))
+		return
+	}
+	teamId, err := resolveTeamId(ctx, r.client, parts[0])
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import deploy", err)
+		return
+	}
+	projectId, err := uuid.Parse(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import deploy, got error: unable to parse project_id: %s", err))
+		return
+	}
+
+	result, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), teamId, projectId)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "import deploy", err)
+		return
+	}
+	if result.Team == nil || result.Team.Project == nil {
+		addClientError(&resp.Diagnostics, "import deploy", fmt.Errorf("project %q not found", parts[1]))
+		return
+	}
+	node, ok := lo.Find(result.Team.Project.Deploys.Nodes, func(d zeetv1.ProjectDetailDeploysDeployConnectionNodesDeploy) bool {
+		return d.Id.String() == parts[2] || d.Name == parts[2]
+	})
+	if !ok {
+		addClientError(&resp.Diagnostics, "import deploy", fmt.Errorf("deploy %q not found", parts[2]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), customtypes.NewUUIDValue(teamId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), customtypes.NewUUIDValue(projectId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), customtypes.NewUUIDValue(node.Id))...)
+}
+
+// readComputed sets the attributes of the deploy chosen by Zeet once it is created or updated.
+func (r *ProjectDeployResource) readComputed(ctx context.Context, data *ProjectDeployResourceModel) error {
+	result, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), data.TeamId.ValueUUID(), data.ProjectId.ValueUUID())
+	if err != nil {
+		return err
+	}
+	if result.Team == nil || result.Team.Project == nil {
+		return fmt.Errorf("project %s not found", data.ProjectId.ValueUUID())
+	}
+
+	deploy := data.deploy()
+	deploy.readComputed(findDeploy(result.Team.Project.Deploys.Nodes, data.Id.ValueUUID()))
+	data.setDeploy(deploy)
+	return nil
+}
+
+// latestRollout returns the latest workflow run of the project when it will be waited for.
+func (r *ProjectDeployResource) latestRollout(ctx context.Context, data *ProjectDeployResourceModel) (uuid.UUID, error) {
+	if !data.WaitForWorkflowRun.ValueBool() {
+		return uuid.Nil, nil
+	}
+	return latestWorkflowRunId(ctx, r.client, data.TeamId.ValueUUID(), data.ProjectId.ValueUUID())
+}
+
+// waitForRollout waits for the workflow run started after previous when enabled by wait_for_workflow_run.
+// When the change is not expected to roll out, only a run that already started is waited for.
+func (r *ProjectDeployResource) waitForRollout(ctx context.Context, data *ProjectDeployResourceModel, previous uuid.UUID, expected bool, diags *diag.Diagnostics) {
+	if !data.WaitForWorkflowRun.ValueBool() {
+		return
+	}
+
+	startTimeout := time.Duration(0)
+	if expected {
+		startTimeout = waitStartGracePeriod
+	}
+
+	if err := waitForWorkflowRun(ctx, r.client, data.TeamId.ValueUUID(), data.ProjectId.ValueUUID(), previous, startTimeout); err != nil {
+		addWaitError(diags, "wait for workflow run", err)
+	}
+}
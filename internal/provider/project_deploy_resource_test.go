@@ -0,0 +1,158 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDeployResource(t *testing.T) {
+	server := testAccProjectWorkflowServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectDeployResourceConfig(server.URL, "replicas: 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "project_id", testProjectId.String()),
+					resource.TestCheckResourceAttrSet("zeet_project_deploy.test", "id"),
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "name", "deploy-1"),
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "helm_config.values", "replicas: 2"),
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "helm_config.namespace", "default"),
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "helm_config.release_name", "one"),
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "wait_for_workflow_run", "true"),
+					resource.TestCheckResourceAttr("zeet_project.test", "deploys.%", "1"),
+					resource.TestCheckResourceAttr("zeet_project.test", "deploys.main.id", testDeployId.String()),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectDeployResourceConfig(server.URL, "replicas: 3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "name", "deploy-1"),
+					resource.TestCheckResourceAttr("zeet_project_deploy.test", "helm_config.values", "replicas: 3"),
+					resource.TestCheckResourceAttr("zeet_project.test", "deploys.main.helm_config.values", "replicas: 1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_project_deploy.test",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/" + testProjectId.String() + "/deploy-1",
+				ImportStateVerify: true,
+				// the wait setting is not stored in Zeet
+				ImportStateVerifyIgnore: []string{"wait_for_workflow_run"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectDeployResourceConfig(server string, values string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = "one"
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  deploys = {
+    main = {
+      default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
+      helm_config = {
+        cluster_id = %[2]q
+        values     = "replicas: 1"
+      }
+    }
+  }
+
+  workflow = {
+    steps: jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
+  }
+
+  enabled = true
+}
+
+resource "zeet_project_deploy" "test" {
+  team_id    = zeet_project.test.team_id
+  project_id = zeet_project.test.id
+
+  default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPLY"]
+  helm_config = {
+    cluster_id = %[2]q
+    values     = %[3]q
+  }
+
+  wait_for_workflow_run = true
+}
+`, server, testClusterId.String(), values)
+}
+
+func TestAccProjectDeployResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// No driver
+			{
+				Config:      testAccProjectDeployResourceInvalidConfig(``),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured:\s+\[helm,helm_config,kubernetes,kubernetes_config,terraform,terraform_config\]`),
+			},
+			// Both a helm and a terraform driver
+			{
+				Config: testAccProjectDeployResourceInvalidConfig(`
+  helm_config = {
+    cluster_id = "0b7ab3e6-ed3b-4ea9-a72a-51b8ed3c5e35"
+    values     = "replicas: 1"
+  }
+  terraform_config = {
+    provider = {
+      do_account_id = "0b7ab3e6-ed3b-4ea9-a72a-51b8ed3c5e35"
+    }
+    state_backend = {
+      s3_bucket = {
+        aws_account_id = "0b7ab3e6-ed3b-4ea9-a72a-51b8ed3c5e35"
+        bucket_name    = "terraform-state"
+        region         = "us-east-1"
+      }
+    }
+    source = {
+      module = {
+        source = "terraform-aws-modules/s3-bucket/aws"
+      }
+    }
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured:\s+\[helm,helm_config,kubernetes,kubernetes_config,terraform,terraform_config\]`),
+			},
+		},
+	})
+}
+
+func testAccProjectDeployResourceInvalidConfig(body string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = "http://localhost"
+}
+
+resource "zeet_project_deploy" "test_invalid" {
+  team_id    = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  project_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  default_workflow_steps = ["DRIVER_APPLY"]
+%s}
+`, body)
+}
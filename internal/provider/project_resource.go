@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"reflect"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,19 +24,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/samber/lo"
 
-	"github.com/zeet-dev/cli/pkg/api"
 	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
 	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectResource{}
+
+// projectInputFields maps the API project input fields to their attributes.
+var projectInputFields = map[string]path.Path{
+	"name":       path.Root("name"),
+	"groupId":    path.Root("group_id"),
+	"subGroupId": path.Root("subgroup_id"),
+}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -38,7 +54,7 @@ func NewProjectResource() resource.Resource {
 
 // ProjectResource defines the resource implementation.
 type ProjectResource struct {
-	client *api.Client
+	client *zeetClient
 }
 
 // ProjectResourceModel describes the resource data model.
@@ -52,22 +68,30 @@ type ProjectResourceModel struct {
 	Enabled     types.Bool            `tfsdk:"enabled"`
 	BlueprintId customtypes.UUIDValue `tfsdk:"blueprint_id"`
 
+	WaitForDeploy      types.Bool `tfsdk:"wait_for_deploy"`
+	WaitForWorkflowRun types.Bool `tfsdk:"wait_for_workflow_run"`
+
 	// for IAC based projects
-	Deploys  []ProjectDeployModel  `tfsdk:"deploys"`
-	Workflow *ProjectWorkflowModel `tfsdk:"workflow"`
+	Deploys  map[string]ProjectDeployModel `tfsdk:"deploys"`
+	Workflow *ProjectWorkflowModel         `tfsdk:"workflow"`
 
 	// for Container based projects
 	Container *ProjectContainerModel `tfsdk:"container"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ProjectDeployModel struct {
-	Id                   customtypes.UUIDValue `tfsdk:"id"`
-	DefaultWorkflowSteps []types.String        `tfsdk:"default_workflow_steps"`
-	RequirePlanApproval  types.Bool            `tfsdk:"require_plan_approval"`
-	Variables            jsontypes.Normalized  `tfsdk:"variables"`
-	Kubernetes           jsontypes.Normalized  `tfsdk:"kubernetes"`
-	Helm                 jsontypes.Normalized  `tfsdk:"helm"`
-	Terraform            jsontypes.Normalized  `tfsdk:"terraform"`
+	Id                   customtypes.UUIDValue         `tfsdk:"id"`
+	DefaultWorkflowSteps []types.String                `tfsdk:"default_workflow_steps"`
+	RequirePlanApproval  types.Bool                    `tfsdk:"require_plan_approval"`
+	Variables            jsontypes.Normalized          `tfsdk:"variables"`
+	Kubernetes           jsontypes.Normalized          `tfsdk:"kubernetes"`
+	KubernetesConfig     *ProjectDeployKubernetesModel `tfsdk:"kubernetes_config"`
+	Helm                 jsontypes.Normalized          `tfsdk:"helm"`
+	HelmConfig           *ProjectDeployHelmModel       `tfsdk:"helm_config"`
+	Terraform            jsontypes.Normalized          `tfsdk:"terraform"`
+	TerraformConfig      *ProjectDeployTerraformModel  `tfsdk:"terraform_config"`
 }
 
 type ProjectWorkflowModel struct {
@@ -76,12 +100,13 @@ type ProjectWorkflowModel struct {
 }
 
 type ProjectContainerModel struct {
-	RepoId     customtypes.UUIDValue          `tfsdk:"repo_id"`
-	Source     ProjectContainerSourceModel    `tfsdk:"source"`
-	Branch     *ProjectContainerBranchModel   `tfsdk:"branch"`
-	Workflow   *ProjectContainerWorkflowModel `tfsdk:"workflow"`
-	Build      jsontypes.Normalized           `tfsdk:"build"`
-	Kubernetes jsontypes.Normalized           `tfsdk:"kubernetes"`
+	RepoId           customtypes.UUIDValue            `tfsdk:"repo_id"`
+	Source           ProjectContainerSourceModel      `tfsdk:"source"`
+	Branch           *ProjectContainerBranchModel     `tfsdk:"branch"`
+	Workflow         *ProjectContainerWorkflowModel   `tfsdk:"workflow"`
+	Build            jsontypes.Normalized             `tfsdk:"build"`
+	Kubernetes       jsontypes.Normalized             `tfsdk:"kubernetes"`
+	KubernetesConfig *ProjectContainerKubernetesModel `tfsdk:"kubernetes_config"`
 }
 
 type ProjectContainerSourceModel struct {
@@ -120,6 +145,8 @@ func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// version 1 keys the deploys by name, see UpgradeState
+		Version: 1,
 		MarkdownDescription: "The `zeet_project` resource manages Zeet projects, categorized into two types based on deployment strategies: " +
 			"Container-based and Workflow-based projects.\n\n" +
 			"**Container-based Projects**: The `container` attribute defines deployment specifications for Docker containers, " +
@@ -129,7 +156,10 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"Kubernetes manifests, or using Terraform for infrastructure as code. `workflow` outlines the deployment's sequence " +
 			"of operations, such as build and deploy steps, while `deploys` specifies the deployment configuration, including driver execution steps.\n\n" +
 			"Projects must use one of these configurations without combining them within the same project. It's possible to define " +
-			"multiple projects with different configurations to create more complex architectures.",
+			"multiple projects with different configurations to create more complex architectures.\n\n" +
+			"Existing projects can be imported with an identifier in the format `team/project_id` or `team/group/subgroup/project`, " +
+			"where team, group, subgroup and project are either names or identifiers; " +
+			"the project type is detected and the configuration is read from Zeet.",
 		Attributes: map[string]schema.Attribute{
 			"team_id": schema.StringAttribute{
 				MarkdownDescription: "Team identifier",
@@ -140,20 +170,14 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"group_id": schema.StringAttribute{
-				MarkdownDescription: "Group identifier",
+				MarkdownDescription: "Group identifier, changing it moves the project",
 				Required:            true,
 				CustomType:          customtypes.UUIDType{},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // TODO: allow moving projects between groups
-				},
 			},
 			"subgroup_id": schema.StringAttribute{
-				MarkdownDescription: "Subgroup identifier",
+				MarkdownDescription: "Subgroup identifier, changing it moves the project",
 				Required:            true,
 				CustomType:          customtypes.UUIDType{},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // TODO: allow moving projects between groups
-				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -181,51 +205,32 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
-			"deploys": schema.ListNestedAttribute{
-				MarkdownDescription: "Deployment configurations",
-				Optional:            true,
+			"wait_for_deploy": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the deployment started by a create or update of a container project to finish, " +
+					"failing the apply with the failure reason and logs if it fails. The wait is bounded by the create and update timeouts.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("workflow")),
+				},
+			},
+			"wait_for_workflow_run": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the workflow run started by a create or update of a workflow project to finish, " +
+					"failing the apply with the failed steps and their logs if it fails. The wait is bounded by the create and update timeouts.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("container")),
+				},
+			},
+			"deploys": schema.MapNestedAttribute{
+				MarkdownDescription: "Deployment configurations keyed by a name of your choice, imported deploys and the deploys of a " +
+					"state written by an earlier version of the provider are keyed by their name in Zeet",
+				Optional: true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "Deployment identifier",
-							Computed:            true,
-							CustomType:          customtypes.UUIDType{},
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
-						},
-						"default_workflow_steps": schema.ListAttribute{
-							MarkdownDescription: "Default workflow steps for deployment list of [step types](https://docs.zeet.co/graphql/enums/blueprint-driver-workflow-step-action/)",
-							Required:            true,
-							ElementType:         types.StringType,
-						},
-						"require_plan_approval": schema.BoolAttribute{
-							MarkdownDescription: "Indicates if the approval step is required in all workflow runs",
-							Optional:            true,
-							Computed:            true,
-							Default:             booldefault.StaticBool(false),
-						},
-						"variables": schema.StringAttribute{
-							MarkdownDescription: "Blueprint variables, GraphQL type [`[BlueprintVariableInput]`](https://docs.zeet.co/graphql/inputs/blueprint-variable-input/)",
-							Optional:            true,
-							CustomType:          jsontypes.NormalizedType{},
-						},
-						"kubernetes": schema.StringAttribute{
-							MarkdownDescription: "Kubernetes deployment configuration in [JSON format](https://docs.zeet.co/graphql/inputs/deployment-configuration-kubernetes-input/)",
-							Optional:            true,
-							CustomType:          jsontypes.NormalizedType{},
-						},
-						"helm": schema.StringAttribute{
-							MarkdownDescription: "Helm deployment configuration in [JSON format](https://docs.zeet.co/graphql/inputs/deployment-configuration-kubernetes-helm-input/)",
-							Optional:            true,
-							CustomType:          jsontypes.NormalizedType{},
-						},
-						"terraform": schema.StringAttribute{
-							MarkdownDescription: "Terraform deployment configuration in [JSON format](https://docs.zeet.co/graphql/inputs/deployment-configuration-terraform-input/)",
-							Optional:            true,
-							CustomType:          jsontypes.NormalizedType{},
-						},
-					}},
+					Attributes: projectDeployAttributes(),
+				},
 			},
 			"workflow": schema.SingleNestedAttribute{
 				MarkdownDescription: "Workflow configuration",
@@ -269,6 +274,12 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 								MarkdownDescription: "Git configuration for container deployment in [JSON format](https://docs.zeet.co/0.1.0/graphql/inputs/git-source-input/)",
 								Optional:            true,
 								CustomType:          jsontypes.NormalizedType{},
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(
+										path.MatchRoot("container").AtName("source").AtName("git"),
+										path.MatchRoot("container").AtName("source").AtName("container_registry"),
+									),
+								},
 							},
 							"container_registry": schema.StringAttribute{
 								MarkdownDescription: "Container registry configuration for container deployment in [JSON format](https://docs.zeet.co/0.1.0/graphql/inputs/container-registry-source-input/)",
@@ -289,29 +300,37 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 								Default:             stringdefault.StaticString("production"),
 							},
 							"auto_deploy_branch": schema.BoolAttribute{
-								MarkdownDescription: "Indicates if auto deploy branch is enabled\nUpdate is not supported at the moment",
+								MarkdownDescription: "Indicates if auto deploy branch is enabled",
 								Optional:            true,
 							},
 							"auto_stop_branch": schema.BoolAttribute{
-								MarkdownDescription: "Indicates if auto stop branch is enabled\nUpdate is not supported at the moment",
+								MarkdownDescription: "Indicates if auto stop branch is enabled",
 								Optional:            true,
 							},
 							"branch_ignore": schema.StringAttribute{
-								MarkdownDescription: "Branch ignore for container deployment\nUpdate is not supported at the moment",
+								MarkdownDescription: "Branch ignore for container deployment",
 								Optional:            true,
 							},
 							"branch_stop_ignore": schema.StringAttribute{
-								MarkdownDescription: "Branch stop ignore for container deployment\nUpdate is not supported at the moment",
+								MarkdownDescription: "Branch stop ignore for container deployment",
 								Optional:            true,
 							},
 						},
 						Default: objectdefault.StaticValue(
 							types.ObjectValueMust(
 								map[string]attr.Type{
-									"production_branch": types.StringType,
+									"production_branch":  types.StringType,
+									"auto_deploy_branch": types.BoolType,
+									"auto_stop_branch":   types.BoolType,
+									"branch_ignore":      types.StringType,
+									"branch_stop_ignore": types.StringType,
 								},
 								map[string]attr.Value{
-									"production_branch": types.StringValue("production"),
+									"production_branch":  types.StringValue("production"),
+									"auto_deploy_branch": types.BoolNull(),
+									"auto_stop_branch":   types.BoolNull(),
+									"branch_ignore":      types.StringNull(),
+									"branch_stop_ignore": types.StringNull(),
 								},
 							),
 						),
@@ -350,18 +369,42 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 					},
 					"build": schema.StringAttribute{
-						MarkdownDescription: "Build configuration for the container deployment in [JSON format](https://docs.zeet.co/0.1.0/graphql/inputs/resource-build-input/)\nUpdate is not supported at the moment",
+						MarkdownDescription: "Build configuration for the container deployment in [JSON format](https://docs.zeet.co/0.1.0/graphql/inputs/resource-build-input/)\nChanging `buildTarget` forces a new project",
 						Optional:            true,
 						CustomType:          jsontypes.NormalizedType{},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplaceIf(jsonFieldRequiresReplace(func(v zeetv0.ResourceBuildInput) any { return v.BuildTarget }),
+								"Changing the build target requires replacing the project",
+								"Changing the build target requires replacing the project"),
+						},
 					},
 					"kubernetes": schema.StringAttribute{
-						MarkdownDescription: "Kubernetes configuration for the container deployment in [JSON format](https://docs.zeet.co/0.1.0/graphql/inputs/resource-kubernetes-input/)\nUpdate is not supported at the moment",
-						Required:            true,
+						MarkdownDescription: "Kubernetes configuration for the container deployment in [JSON format](https://docs.zeet.co/0.1.0/graphql/inputs/resource-kubernetes-input/)\nChanging `deployTarget` forces a new project",
+						DeprecationMessage:  "Use kubernetes_config instead, this attribute will be removed in the next release",
+						Optional:            true,
 						CustomType:          jsontypes.NormalizedType{},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplaceIf(jsonFieldRequiresReplace(func(v zeetv0.ResourceKubernetesInput) any { return v.DeployTarget }),
+								"Changing the deploy target requires replacing the project",
+								"Changing the deploy target requires replacing the project"),
+						},
 					},
+					"kubernetes_config": projectContainerKubernetesSchema(),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ProjectResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// a project is either a container project or a workflow project with its deploys
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("container"), path.MatchRoot("workflow")),
+		resourcevalidator.RequiredTogether(path.MatchRoot("workflow"), path.MatchRoot("deploys")),
+		resourcevalidator.Conflicting(path.MatchRoot("container"), path.MatchRoot("deploys")),
 	}
 }
 
@@ -371,12 +414,12 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*zeetClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -395,6 +438,12 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.IsContainer() {
 		createInput := zeetv0.CreateResourceAlphaInput{
 			UserID:        data.TeamId.ValueUUID(),
@@ -474,18 +523,17 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 			}
 		}
 
-		if !data.Container.Kubernetes.IsNull() {
-			createInput.Kubernetes = &zeetv0.ResourceKubernetesInput{}
-			if err := json.Unmarshal([]byte(data.Container.Kubernetes.ValueString()), &createInput.Kubernetes); err != nil {
-				resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal kubernetes, got error: %s", err))
-				return
-			}
+		kubernetesInput, err := data.Container.kubernetesInput()
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal kubernetes, got error: %s", err))
+			return
 		}
+		createInput.Kubernetes = kubernetesInput
 
 		// Create container project
 		result, err := zeetv0.CreateResourceAlphaMutation(ctx, r.client.Client(), createInput)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
+			addClientAttributeError(&resp.Diagnostics, "create project", err, projectInputFields)
 			return
 		}
 
@@ -493,7 +541,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		pv3Result, err := zeetv0.ProjectV3Query(ctx, r.client.Client(), data.TeamId.ValueUUID().String(), result.CreateResourceAlpha.Project.Name, result.CreateResourceAlpha.ProjectEnvironment.Name,
 			result.CreateResourceAlpha.Name)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			addClientError(&resp.Diagnostics, "read project", err)
 			return
 		}
 
@@ -514,7 +562,8 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 			Name:        data.Name.ValueString(),
 			BlueprintId: lo.ToPtr(data.BlueprintId.ValueUUID()),
 
-			Enabled: lo.ToPtr(data.Enabled.ValueBool()),
+			// the project is enabled once its deploys are created
+			Enabled: lo.ToPtr(false),
 		}
 
 		if !data.Workflow.Steps.IsNull() {
@@ -530,70 +579,59 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
-		for _, deploy := range data.Deploys {
-			input := zeetv1.DeploymentConfigurationInput{
-				DefaultWorkflowSteps: lo.Map(deploy.DefaultWorkflowSteps, func(s types.String, _ int) zeetv1.BlueprintDriverWorkflowStepAction {
-					return zeetv1.BlueprintDriverWorkflowStepAction(s.ValueString())
-				}),
-			}
-
-			if !deploy.RequirePlanApproval.IsNull() {
-				input.RequirePlanApproval = lo.ToPtr(deploy.RequirePlanApproval.ValueBool())
-			}
-
-			if !deploy.Helm.IsNull() {
-				input.Helm = &zeetv1.DeploymentConfigurationKubernetesHelmInput{}
-				if err := json.Unmarshal([]byte(deploy.Helm.ValueString()), &input.Helm); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal helm, got error: %s", err))
-					return
-				}
-			}
-
-			if !deploy.Kubernetes.IsNull() {
-				input.Kubernetes = &zeetv1.DeploymentConfigurationKubernetesInput{}
-				if err := json.Unmarshal([]byte(deploy.Kubernetes.ValueString()), &input.Kubernetes); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal kubernetes, got error: %s", err))
-					return
-				}
-			}
-
-			if !deploy.Terraform.IsNull() {
-				input.Terraform = &zeetv1.DeploymentConfigurationTerraformInput{}
-				if err := json.Unmarshal([]byte(deploy.Terraform.ValueString()), &input.Terraform); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal terraform, got error: %s", err))
-					return
-				}
-			}
-
-			if !deploy.Variables.IsNull() {
-				input.Variables = []zeetv1.BlueprintVariableInput{}
-				if err := json.Unmarshal([]byte(deploy.Variables.ValueString()), &input.Variables); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal variables, got error: %s", err))
-					return
-				}
-			}
-
-			createInput.Deploys = append(createInput.Deploys, input)
-		}
-
 		createResult, err := zeetv1.CreateProjectMutation(ctx, r.client.ClientV1(), createInput)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
+			addClientAttributeError(&resp.Diagnostics, "create project", err, projectInputFields)
 			return
 		}
 
 		data.Id = customtypes.NewUUIDValue(createResult.CreateProject.Id)
+		if createResult.CreateProject.Workflow != nil {
+			data.Workflow.Id = customtypes.NewUUIDValue(createResult.CreateProject.Workflow.Id)
+		}
+
+		// Zeet names the deploys itself, they are created one by one to know the identifier of each planned deploy
+		for _, name := range sortedDeployNames(data.Deploys) {
+			deploy := data.Deploys[name]
+			input, diags := deploy.toInput()
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				saveCreatedProject(ctx, &data, &resp.State, &resp.Diagnostics)
+				return
+			}
+			created, err := createDeployMutation(ctx, r.client.ClientV1(), createDeployInput{
+				ProjectId:     data.Id.ValueUUID(),
+				Configuration: input,
+			})
+			if err != nil {
+				addClientError(&resp.Diagnostics, "create deploy", err)
+				saveCreatedProject(ctx, &data, &resp.State, &resp.Diagnostics)
+				return
+			}
+			deploy.Id = customtypes.NewUUIDValue(created.Id)
+			data.Deploys[name] = deploy
+		}
 
 		readResult, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), data.TeamId.ValueUUID(), data.Id.ValueUUID())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			addClientError(&resp.Diagnostics, "read project", err)
+			saveCreatedProject(ctx, &data, &resp.State, &resp.Diagnostics)
 			return
 		}
 
 		data.Name = types.StringValue(readResult.Team.Project.Name)
 		data.Workflow.Id = customtypes.NewUUIDValue(readResult.Team.Project.Workflow.Id)
-		for i, deploy := range readResult.Team.Project.Deploys.Nodes {
-			data.Deploys[i].Id = customtypes.NewUUIDValue(deploy.Id)
+		for _, deploy := range data.Deploys {
+			deploy.readComputed(findDeploy(readResult.Team.Project.Deploys.Nodes, deploy.Id.ValueUUID()))
+		}
+
+		if data.Enabled.ValueBool() {
+			_, err := zeetv1.SubmitWorkflowRunMutation(ctx, r.client.ClientV1(), data.Workflow.Id.ValueUUID(), nil)
+			if err != nil {
+				addClientError(&resp.Diagnostics, "enable project", err)
+				saveCreatedProject(ctx, &data, &resp.State, &resp.Diagnostics)
+				return
+			}
 		}
 	} else {
 		// Not valid
@@ -607,6 +645,24 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForRollout(ctx, &data, projectRollout{}, true, &resp.Diagnostics)
+}
+
+// saveCreatedProject saves the state of a workflow project whose creation failed after the project was created in
+// Zeet, Terraform taints it and replaces it on the next apply instead of creating a duplicate. The deploys that
+// were not created are left out of the state.
+func saveCreatedProject(ctx context.Context, data *ProjectResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	data.Deploys = lo.PickBy(data.Deploys, func(_ string, deploy ProjectDeployModel) bool {
+		return !deploy.Id.IsUnknown()
+	})
+	for _, deploy := range data.Deploys {
+		deploy.readComputed(nil)
+	}
+	diags.Append(state.Set(ctx, data)...)
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -618,11 +674,22 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// read logic
 	if data.IsContainer() {
 		getResult, err := zeetv0.UserRepoQuery(ctx, r.client.Client(), data.Container.RepoId.ValueUUID().String())
+		if apierrors.IsNotFound(err) || err == nil && getResult.CurrentUser.Repo == nil {
+			tflog.Warn(ctx, "Project not found, removing it from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			addClientError(&resp.Diagnostics, "read project", err)
 			return
 		}
 
@@ -631,25 +698,32 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		data.SubGroupId = customtypes.NewUUIDValue(getResult.CurrentUser.Repo.ProjectEnvironment.Id)
 		data.Name = types.StringValue(getResult.CurrentUser.Repo.Name)
 		prevContainer := data.Container
+		// kubernetes is required, so an empty configuration means the project is being imported
+		imported := prevContainer.KubernetesConfig == nil && prevContainer.Kubernetes.IsNull()
+		if imported {
+			data.Enabled = types.BoolValue(getResult.CurrentUser.Repo.Enabled)
+		}
 		data.Container = &ProjectContainerModel{
 			RepoId: customtypes.NewUUIDValue(uuid.MustParse(getResult.CurrentUser.Repo.Id)),
 		}
 
 		// branch
+		// unconfigured settings are reported as empty values by the API, keep them null
+		prevBranch := lo.FromPtr(prevContainer.Branch)
 		data.Container.Branch = &ProjectContainerBranchModel{}
 		if getResult.CurrentUser.Repo.ProductionBranch != nil {
 			data.Container.Branch.ProductionBranch = types.StringValue(*getResult.CurrentUser.Repo.ProductionBranch)
 		}
 		if getResult.CurrentUser.Repo.GithubIntegration != nil {
-			data.Container.Branch.AutoDeployBranch = types.BoolValue(getResult.CurrentUser.Repo.GithubIntegration.AutoDeployBranch)
-			data.Container.Branch.AutoStopBranch = types.BoolValue(getResult.CurrentUser.Repo.GithubIntegration.AutoStopBranch)
-			data.Container.Branch.BranchIgnore = types.StringValue(getResult.CurrentUser.Repo.GithubIntegration.BranchIgnore)
-			data.Container.Branch.BranchStopIgnore = types.StringValue(getResult.CurrentUser.Repo.GithubIntegration.BranchStopIgnore)
+			data.Container.Branch.AutoDeployBranch = boolValueOrNull(getResult.CurrentUser.Repo.GithubIntegration.AutoDeployBranch, prevBranch.AutoDeployBranch)
+			data.Container.Branch.AutoStopBranch = boolValueOrNull(getResult.CurrentUser.Repo.GithubIntegration.AutoStopBranch, prevBranch.AutoStopBranch)
+			data.Container.Branch.BranchIgnore = stringValueOrNull(getResult.CurrentUser.Repo.GithubIntegration.BranchIgnore, prevBranch.BranchIgnore)
+			data.Container.Branch.BranchStopIgnore = stringValueOrNull(getResult.CurrentUser.Repo.GithubIntegration.BranchStopIgnore, prevBranch.BranchStopIgnore)
 		} else if getResult.CurrentUser.Repo.GitlabIntegration != nil {
-			data.Container.Branch.AutoDeployBranch = types.BoolValue(getResult.CurrentUser.Repo.GitlabIntegration.AutoDeployBranch)
-			data.Container.Branch.AutoStopBranch = types.BoolValue(getResult.CurrentUser.Repo.GitlabIntegration.AutoStopBranch)
-			data.Container.Branch.BranchIgnore = types.StringValue(getResult.CurrentUser.Repo.GitlabIntegration.BranchIgnore)
-			data.Container.Branch.BranchStopIgnore = types.StringValue(getResult.CurrentUser.Repo.GitlabIntegration.BranchStopIgnore)
+			data.Container.Branch.AutoDeployBranch = boolValueOrNull(getResult.CurrentUser.Repo.GitlabIntegration.AutoDeployBranch, prevBranch.AutoDeployBranch)
+			data.Container.Branch.AutoStopBranch = boolValueOrNull(getResult.CurrentUser.Repo.GitlabIntegration.AutoStopBranch, prevBranch.AutoStopBranch)
+			data.Container.Branch.BranchIgnore = stringValueOrNull(getResult.CurrentUser.Repo.GitlabIntegration.BranchIgnore, prevBranch.BranchIgnore)
+			data.Container.Branch.BranchStopIgnore = stringValueOrNull(getResult.CurrentUser.Repo.GitlabIntegration.BranchStopIgnore, prevBranch.BranchStopIgnore)
 		}
 
 		// workflow
@@ -701,29 +775,14 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 			data.Container.Source.Git = jsontypes.NewNormalizedValue(string(valJson))
 
 			// build
-			buildInput := zeetv0.ResourceBuildInput{
-				GitSubmodules: getResult.CurrentUser.Repo.GitSubmodules,
-				// TODO: add other misc build fields
-			}
-			if getResult.CurrentUser.Repo.BuildMethod != nil {
-				buildInput.Build = &zeetv0.ProjectBuildInput{
-					BuildType:        &getResult.CurrentUser.Repo.BuildMethod.Type,
-					DockerfilePath:   getResult.CurrentUser.Repo.BuildMethod.DockerfilePath,
-					WorkingDirectory: getResult.CurrentUser.Repo.BuildMethod.WorkingDirectory,
-					BuildCommand:     getResult.CurrentUser.Repo.BuildMethod.BuildCommand,
-					RunCommand:       getResult.CurrentUser.Repo.BuildMethod.RunCommand,
-					StaticPath:       getResult.CurrentUser.Repo.BuildMethod.StaticPath,
-					NodejsVersion:    getResult.CurrentUser.Repo.BuildMethod.NodejsVersion,
-					PythonVersion:    getResult.CurrentUser.Repo.BuildMethod.PythonVersion,
-					GolangVersion:    getResult.CurrentUser.Repo.BuildMethod.GolangVersion,
+			if !prevContainer.Build.IsNull() || imported {
+				buildInput, err := newResourceBuildInput(getResult.CurrentUser.Repo, prevContainer.Build)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+					return
 				}
+				data.Container.Build = buildInput
 			}
-			valJson, err = json.Marshal(buildInput)
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-				return
-			}
-			data.Container.Build = jsontypes.NewNormalizedValue(string(valJson))
 		default:
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", "source type not supported"))
 			return
@@ -731,7 +790,17 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 		// kubernetes
 		data.Container.Kubernetes = prevContainer.Kubernetes
-		if getResult.CurrentUser.Repo.DeployTarget != nil && *getResult.CurrentUser.Repo.DeployTarget == zeetv0.DeployTargetKubernetes {
+		if getResult.CurrentUser.Repo.DeployTarget == nil || *getResult.CurrentUser.Repo.DeployTarget != zeetv0.DeployTargetKubernetes {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", "deploy target not kubernetes"))
+			return
+		} else if imported || prevContainer.KubernetesConfig != nil {
+			kubernetesConfig, err := newProjectContainerKubernetesModel(getResult.CurrentUser.Repo, prevContainer.KubernetesConfig)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+				return
+			}
+			data.Container.KubernetesConfig = kubernetesConfig
+		} else {
 			input := zeetv0.ResourceKubernetesInput{
 				DeployTarget: &zeetv0.ProjectDeployInput{
 					DeployTarget: *getResult.CurrentUser.Repo.DeployTarget,
@@ -763,9 +832,19 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 			// resource
 			if getResult.CurrentUser.Repo.Cpu != nil && getResult.CurrentUser.Repo.Memory != nil &&
 				*getResult.CurrentUser.Repo.Cpu != "" && *getResult.CurrentUser.Repo.Memory != "" {
+				cpu, err := parseContainerCpu(*getResult.CurrentUser.Repo.Cpu)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+					return
+				}
+				memory, err := parseContainerMemory(*getResult.CurrentUser.Repo.Memory)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+					return
+				}
 				input.App.Resources = &zeetv0.ContainerResourcesSpecInput{
-					Cpu:              lo.Must(strconv.ParseFloat(*getResult.CurrentUser.Repo.Cpu, 64)),
-					Memory:           lo.Must(strconv.ParseFloat(strings.TrimSuffix(*getResult.CurrentUser.Repo.Memory, "G"), 64)),
+					Cpu:              cpu,
+					Memory:           memory,
 					EphemeralStorage: getResult.CurrentUser.Repo.EphemeralStorage,
 					// Accelerator: &zeetv0.ContainerResourcesAcceleratorSpecInput{},
 				}
@@ -779,130 +858,53 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 				return
 			}
 			data.Container.Kubernetes = jsontypes.NewNormalizedValue(string(valJson))
-		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", "deploy target not kubernetes"))
-			return
 		}
 	} else if data.IsWorkflow() {
 		readResult, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), data.TeamId.ValueUUID(), data.Id.ValueUUID())
+		if apierrors.IsNotFound(err) || err == nil && (readResult.Team == nil || readResult.Team.Project == nil) {
+			tflog.Warn(ctx, "Project not found, removing it from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			addClientError(&resp.Diagnostics, "read project", err)
 			return
 		}
 
 		data.Name = types.StringValue(readResult.Team.Project.Name)
 		data.Enabled = types.BoolValue(readResult.Team.Project.Status != zeetv1.ProjectStatusPaused)
+		if readResult.Team.Project.Group != nil {
+			data.GroupId = customtypes.NewUUIDValue(readResult.Team.Project.Group.Id)
+		}
+		if readResult.Team.Project.SubGroup != nil {
+			data.SubGroupId = customtypes.NewUUIDValue(readResult.Team.Project.SubGroup.Id)
+		}
 
 		// workflow
 		data.Workflow.Id = customtypes.NewUUIDValue(readResult.Team.Project.Workflow.Id)
+		data.Workflow.Steps, err = readWorkflowSteps(ctx, r.client.ClientV1(), data.TeamId.ValueUUID(), data.Id.ValueUUID(), data.Workflow.Steps)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read project", err)
+			return
+		}
 
-		// deploys
-		for i, deploy := range readResult.Team.Project.Deploys.Nodes {
-			data.Deploys[i].Id = customtypes.NewUUIDValue(deploy.Id)
-			if deploy.Configuration == nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", "deploy configuration is missing"))
-				return
-			}
-			data.Deploys[i].DefaultWorkflowSteps = lo.Map(deploy.Configuration.DefaultWorkflowSteps, func(s zeetv1.BlueprintDriverWorkflowStepAction, _ int) types.String {
-				return types.StringValue(string(s))
-			})
-			if deploy.Configuration.RequirePlanApproval != nil {
-				data.Deploys[i].RequirePlanApproval = types.BoolValue(*deploy.Configuration.RequirePlanApproval)
+		// deploys are matched by identifier, the deploys deleted outside of Terraform are removed from the state
+		nodes := lo.KeyBy(readResult.Team.Project.Deploys.Nodes, func(d zeetv1.ProjectDetailDeploysDeployConnectionNodesDeploy) uuid.UUID {
+			return d.Id
+		})
+		deploys := map[string]ProjectDeployModel{}
+		for name, deploy := range data.Deploys {
+			node, ok := nodes[deploy.Id.ValueUUID()]
+			if !ok {
+				continue
 			}
-			if deploy.Configuration.Variables != nil && len(deploy.Configuration.Variables) > 0 {
-				valJson, err := json.Marshal(deploy.Configuration.Variables)
-				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-					return
-				}
-				input := []zeetv1.BlueprintVariableInput{}
-				if err := json.Unmarshal(valJson, &input); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal variables, got error: %s", err))
-					return
-				}
-				for j := range input {
-					input[j].SpecId = nil
-					if deploy.Configuration.Variables[j].ValueString != nil {
-						input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeString)
-						input[j].Value = *deploy.Configuration.Variables[j].ValueString
-					} else if deploy.Configuration.Variables[j].ValueInt != nil {
-						input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeInteger)
-						input[j].Value = strconv.FormatInt(int64(*deploy.Configuration.Variables[j].ValueInt), 10)
-					} else if deploy.Configuration.Variables[j].ValueFloat != nil {
-						input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeFloat)
-						input[j].Value = strconv.FormatFloat(*deploy.Configuration.Variables[j].ValueFloat, 'f', -1, 64)
-					} else if deploy.Configuration.Variables[j].ValueBoolean != nil {
-						input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeBoolean)
-						input[j].Value = strconv.FormatBool(*deploy.Configuration.Variables[j].ValueBoolean)
-					} else if deploy.Configuration.Variables[j].ValueJson != nil {
-						input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeJson)
-						input[j].Value = *deploy.Configuration.Variables[j].ValueJson
-					}
-				}
-				inputJson, err := json.Marshal(input)
-				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-					return
-				}
-				data.Deploys[i].Variables = jsontypes.NewNormalizedValue(string(inputJson))
-			}
-			if deploy.Configuration.Kubernetes != nil {
-				// TODO: explicit transformation
-				valJson, err := json.Marshal(deploy.Configuration.Kubernetes)
-				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-					return
-				}
-				data.Deploys[i].Kubernetes = jsontypes.NewNormalizedValue(string(valJson))
-			}
-			if deploy.Configuration.Helm != nil {
-				// TODO: explicit transformation
-				valJson, err := json.Marshal(deploy.Configuration.Helm)
-				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-					return
-				}
-				data.Deploys[i].Helm = jsontypes.NewNormalizedValue(string(valJson))
-			}
-			if deploy.Configuration.Terraform != nil {
-				valJson, err := json.Marshal(deploy.Configuration.Terraform)
-				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-					return
-				}
-				input := zeetv1.DeploymentConfigurationTerraformInput{}
-				if err := json.Unmarshal(valJson, &input); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal terraform, got error: %s", err))
-					return
-				}
-				// manually fix the input
-				if deploy.Configuration.Terraform.Target.Provider.AwsAccount != nil {
-					input.Target.Provider.AwsAccountId = lo.ToPtr(deploy.Configuration.Terraform.Target.Provider.AwsAccount.Id)
-				} else if deploy.Configuration.Terraform.Target.Provider.GcpAccount != nil {
-					input.Target.Provider.GcpAccountId = lo.ToPtr(deploy.Configuration.Terraform.Target.Provider.GcpAccount.Id)
-				} else {
-					resp.Diagnostics.AddError("Invalid Configuration", "Terraform provider must be either AWS or GCP")
-					return
-				}
-
-				if deploy.Configuration.Terraform.Blueprint.OutputConfiguration != nil &&
-					lo.IsEmpty(*deploy.Configuration.Terraform.Blueprint.OutputConfiguration) {
-					input.Blueprint.OutputConfiguration = nil
-				}
-
-				if deploy.Configuration.Terraform.Blueprint.Source.TerraformModule != nil && deploy.Configuration.Terraform.Blueprint.Source.TerraformModule.Integration != nil &&
-					lo.IsEmpty(*deploy.Configuration.Terraform.Blueprint.Source.TerraformModule.Integration) {
-					input.Blueprint.Source.TerraformModule.Integration = nil
-				}
-
-				inputJson, err := json.Marshal(input)
-				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-					return
-				}
-				data.Deploys[i].Terraform = jsontypes.NewNormalizedValue(string(inputJson))
+			resp.Diagnostics.Append(deploy.read(&node.DeployConfigurationDetail)...)
+			if resp.Diagnostics.HasError() {
+				return
 			}
+			deploys[name] = deploy
 		}
+		data.Deploys = deploys
 	} else {
 		// Not valid
 		resp.Diagnostics.AddError("Invalid Configuration", "Project must have either a container or workflow configuration")
@@ -922,38 +924,44 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousRollout, err := r.latestRollout(ctx, &plan)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read project deployments", err)
+		return
+	}
+	// whether the changes start a new deployment or workflow run, e.g. renaming a project doesn't
+	rollout := false
+
 	// update logic
 	if state.IsContainer() && plan.IsContainer() {
-		if !plan.Name.Equal(state.Name) {
-			_, err := zeetv0.UpdateProjectSettingsMutation(ctx, r.client.Client(), zeetv0.UpdateProjectInput{
-				Id:   state.Id.ValueUUID().String(),
-				Name: lo.ToPtr(state.Name.ValueString()),
-			})
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
-				return
-			}
-		}
-		// Update Logic
-
 		updateInput := zeetv0.UpdateResourceAlphaInput{}
 
 		// source
-		if !plan.Container.Source.Git.IsNull() && !state.Container.Source.Git.IsNull() && !plan.Container.Source.Git.Equal(state.Container.Source.Git) {
-			updateInput.Source = &zeetv0.SourceInput{
-				Git: &zeetv0.GitSourceInput{},
-			}
-			if err := json.Unmarshal([]byte(plan.Container.Source.Git.ValueString()), &updateInput.Source.Git); err != nil {
-				resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal git source, got error: %s", err))
-				return
-			}
-		} else if !plan.Container.Source.ContainerRegistry.IsNull() && !state.Container.Source.ContainerRegistry.IsNull() && !plan.Container.Source.ContainerRegistry.Equal(state.Container.Source.ContainerRegistry) {
-			updateInput.Source = &zeetv0.SourceInput{
-				ContainerRegistry: &zeetv0.ContainerRegistrySourceInput{},
+		if !plan.Container.Source.Git.IsNull() && !state.Container.Source.Git.IsNull() {
+			if !plan.Container.Source.Git.Equal(state.Container.Source.Git) {
+				updateInput.Source = &zeetv0.SourceInput{
+					Git: &zeetv0.GitSourceInput{},
+				}
+				if err := json.Unmarshal([]byte(plan.Container.Source.Git.ValueString()), &updateInput.Source.Git); err != nil {
+					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal git source, got error: %s", err))
+					return
+				}
 			}
-			if err := json.Unmarshal([]byte(plan.Container.Source.ContainerRegistry.ValueString()), &updateInput.Source.ContainerRegistry); err != nil {
-				resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal container registry source, got error: %s", err))
-				return
+		} else if !plan.Container.Source.ContainerRegistry.IsNull() && !state.Container.Source.ContainerRegistry.IsNull() {
+			if !plan.Container.Source.ContainerRegistry.Equal(state.Container.Source.ContainerRegistry) {
+				updateInput.Source = &zeetv0.SourceInput{
+					ContainerRegistry: &zeetv0.ContainerRegistrySourceInput{},
+				}
+				if err := json.Unmarshal([]byte(plan.Container.Source.ContainerRegistry.ValueString()), &updateInput.Source.ContainerRegistry); err != nil {
+					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal container registry source, got error: %s", err))
+					return
+				}
 			}
 		} else {
 			resp.Diagnostics.AddError("Invalid Configuration", "Container source must be either git or container registry, and the type must not change")
@@ -963,7 +971,20 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		if updateInput.Source != nil {
 			_, err := zeetv0.UpdateResourceAlphaMutation(ctx, r.client.Client(), state.Container.RepoId.ValueUUID(), updateInput)
 			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
+				addClientError(&resp.Diagnostics, "update project", err)
+				return
+			}
+		}
+
+		// group
+		if !plan.GroupId.Equal(state.GroupId) || !plan.SubGroupId.Equal(state.SubGroupId) {
+			_, err := zeetv0.MoveRepoMutation(ctx, r.client.Client(), zeetv0.MoveRepoInput{
+				Id:            state.Container.RepoId.ValueUUID(),
+				ProjectID:     lo.ToPtr(plan.GroupId.ValueUUID()),
+				EnvironmentID: lo.ToPtr(plan.SubGroupId.ValueUUID()),
+			})
+			if err != nil {
+				addClientError(&resp.Diagnostics, "move project", err)
 				return
 			}
 		}
@@ -971,96 +992,216 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		updateRepoInput := zeetv0.UpdateProjectInput{
 			Id: state.Container.RepoId.ValueUUID().String(),
 		}
-		// TODO: branch
+		if !plan.Name.Equal(state.Name) {
+			updateRepoInput.Name = lo.ToPtr(plan.Name.ValueString())
+		}
+
+		// branch
+		if plan.Container.Branch != nil {
+			planBranch, stateBranch := plan.Container.Branch, lo.FromPtr(state.Container.Branch)
+			if !planBranch.ProductionBranch.Equal(stateBranch.ProductionBranch) {
+				updateRepoInput.ProductionBranch = planBranch.ProductionBranch.ValueStringPointer()
+			}
+			if !planBranch.AutoDeployBranch.Equal(stateBranch.AutoDeployBranch) ||
+				!planBranch.AutoStopBranch.Equal(stateBranch.AutoStopBranch) ||
+				!planBranch.BranchIgnore.Equal(stateBranch.BranchIgnore) ||
+				!planBranch.BranchStopIgnore.Equal(stateBranch.BranchStopIgnore) {
+				// branch settings belong to the git integration of the repo,
+				// removed settings are sent as empty values to clear them
+				getResult, err := zeetv0.UserRepoQuery(ctx, r.client.Client(), state.Container.RepoId.ValueUUID().String())
+				if err != nil {
+					addClientError(&resp.Diagnostics, "read project", err)
+					return
+				}
+				if getResult.CurrentUser.Repo.GithubIntegration != nil {
+					updateRepoInput.GithubIntegration = &zeetv0.GitHubRepoIntegrationInput{
+						AutoDeployBranch: lo.ToPtr(planBranch.AutoDeployBranch.ValueBool()),
+						AutoStopBranch:   lo.ToPtr(planBranch.AutoStopBranch.ValueBool()),
+						BranchIgnore:     lo.ToPtr(planBranch.BranchIgnore.ValueString()),
+						BranchStopIgnore: lo.ToPtr(planBranch.BranchStopIgnore.ValueString()),
+					}
+				} else if getResult.CurrentUser.Repo.GitlabIntegration != nil {
+					updateRepoInput.GitlabIntegration = &zeetv0.GitlabRepoIntegrationInput{
+						AutoDeployBranch: lo.ToPtr(planBranch.AutoDeployBranch.ValueBool()),
+						AutoStopBranch:   lo.ToPtr(planBranch.AutoStopBranch.ValueBool()),
+						BranchIgnore:     lo.ToPtr(planBranch.BranchIgnore.ValueString()),
+						BranchStopIgnore: lo.ToPtr(planBranch.BranchStopIgnore.ValueString()),
+					}
+				} else {
+					resp.Diagnostics.AddError("Invalid Configuration", "Branch settings require a GitHub or GitLab integration on the project source")
+					return
+				}
+			}
+		}
 
 		// workflow
-		updateRepoInput.AutoRetry = lo.ToPtr(state.Container.Workflow.AutoRetry.ValueBool())
-		updateRepoInput.AutoRollback = lo.ToPtr(state.Container.Workflow.AutoRollback.ValueBool())
-		updateRepoInput.ManualDeploy = lo.ToPtr(state.Container.Workflow.ManualDeploy.ValueBool())
-		updateRepoInput.PipelineClusterID = lo.ToPtr(state.Container.Workflow.PipelineClusterId.ValueUUID())
-		updateRepoInput.DeployTimeoutSeconds = lo.ToPtr(int(state.Container.Workflow.DeployTimeoutSeconds.ValueInt64()))
+		if plan.Container.Workflow != nil {
+			updateRepoInput.AutoRetry = lo.ToPtr(plan.Container.Workflow.AutoRetry.ValueBool())
+			updateRepoInput.AutoRollback = lo.ToPtr(plan.Container.Workflow.AutoRollback.ValueBool())
+			updateRepoInput.ManualDeploy = lo.ToPtr(plan.Container.Workflow.ManualDeploy.ValueBool())
+			if !plan.Container.Workflow.PipelineClusterId.IsNull() {
+				updateRepoInput.PipelineClusterID = lo.ToPtr(plan.Container.Workflow.PipelineClusterId.ValueUUID())
+			}
+			if !plan.Container.Workflow.DeployTimeoutSeconds.IsNull() {
+				updateRepoInput.DeployTimeoutSeconds = lo.ToPtr(int(plan.Container.Workflow.DeployTimeoutSeconds.ValueInt64()))
+			}
+		}
+
+		// build
+		if !plan.Container.Build.IsNull() && !plan.Container.Build.Equal(state.Container.Build) {
+			buildInput := zeetv0.ResourceBuildInput{}
+			if err := json.Unmarshal([]byte(plan.Container.Build.ValueString()), &buildInput); err != nil {
+				resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal build, got error: %s", err))
+				return
+			}
+			setUpdateProjectBuildInput(&updateRepoInput, buildInput)
+		}
+
+		// kubernetes
+		planKubernetes, err := plan.Container.kubernetesInput()
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal kubernetes, got error: %s", err))
+			return
+		}
+		stateKubernetes, err := state.Container.kubernetesInput()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unmarshal kubernetes state, got error: %s", err))
+			return
+		}
+		if planKubernetes != nil {
+			if stateKubernetes == nil {
+				stateKubernetes = &zeetv0.ResourceKubernetesInput{}
+			}
+			planApp := lo.FromPtr(planKubernetes.App)
+			stateApp := lo.FromPtr(stateKubernetes.App)
+
+			if !reflect.DeepEqual(planKubernetes.Namespace, stateKubernetes.Namespace) ||
+				!reflect.DeepEqual(planApp.UseHumanReadableName, stateApp.UseHumanReadableName) {
+				_, err := zeetv0.UpdateProjectDangerSettingsMutation(ctx, r.client.Client(), zeetv0.UpdateProjectDangerInput{
+					Id:                             state.Container.RepoId.ValueUUID(),
+					Namespace:                      planKubernetes.Namespace,
+					UseHumanReadableKubernetesName: planApp.UseHumanReadableName,
+				})
+				if err != nil {
+					addClientError(&resp.Diagnostics, "update project", err)
+					return
+				}
+			}
 
-		// TODO: build
+			if !reflect.DeepEqual(planApp.Envs, stateApp.Envs) {
+				_, err := zeetv0.SetRepoEnvsMutation(ctx, r.client.Client(), zeetv0.SetRepoEnvsInput{
+					Id:   state.Container.RepoId.ValueUUID().String(),
+					Envs: planApp.Envs,
+				})
+				if err != nil {
+					addClientError(&resp.Diagnostics, "update project environment variables", err)
+					return
+				}
+			}
 
-		// TODO: kubernetes
+			if err := setUpdateProjectKubernetesInput(&updateRepoInput, planApp, stateApp); err != nil {
+				resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to marshal kubernetes, got error: %s", err))
+				return
+			}
+		}
+
+		_, err = zeetv0.UpdateProjectSettingsMutation(ctx, r.client.Client(), updateRepoInput)
+		if err != nil {
+			addClientAttributeError(&resp.Diagnostics, "update project", err, projectInputFields)
+			return
+		}
+		rollout = updateInput.Source != nil || !plan.Container.Build.Equal(state.Container.Build) ||
+			!reflect.DeepEqual(planKubernetes, stateKubernetes)
 
 		// Toggle deployment last
 		if !plan.Enabled.Equal(state.Enabled) {
 			if plan.Enabled.ValueBool() {
 				_, err := zeetv0.EnableProjectMutation(ctx, r.client.Client(), state.Container.RepoId.ValueUUID().String())
 				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resume project, got error: %s", err))
+					addClientError(&resp.Diagnostics, "resume project", err)
 					return
 				}
+				rollout = true
 			} else {
 				_, err := zeetv0.DisableProjectMutation(ctx, r.client.Client(), state.Container.RepoId.ValueUUID().String())
 				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pause project, got error: %s", err))
+					addClientError(&resp.Diagnostics, "pause project", err)
 					return
 				}
 			}
 		}
 	} else if state.IsWorkflow() && plan.IsWorkflow() {
+		updateProjectInput := zeetv1.UpdateProjectInput{}
 		if !plan.Name.Equal(state.Name) {
-			_, err := zeetv1.UpdateProjectMutation(ctx, r.client.ClientV1(), state.Id.ValueUUID(), zeetv1.UpdateProjectInput{
-				Name: lo.ToPtr(state.Name.ValueString()),
-			})
+			updateProjectInput.Name = lo.ToPtr(plan.Name.ValueString())
+		}
+		if !plan.GroupId.Equal(state.GroupId) || !plan.SubGroupId.Equal(state.SubGroupId) {
+			updateProjectInput.GroupId = lo.ToPtr(plan.GroupId.ValueUUID())
+			updateProjectInput.SubGroupId = lo.ToPtr(plan.SubGroupId.ValueUUID())
+		}
+		if !reflect.DeepEqual(updateProjectInput, zeetv1.UpdateProjectInput{}) {
+			_, err := zeetv1.UpdateProjectMutation(ctx, r.client.ClientV1(), state.Id.ValueUUID(), updateProjectInput)
 			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
+				addClientAttributeError(&resp.Diagnostics, "update project", err, projectInputFields)
 				return
 			}
 		}
 
-		// Update Logic
-		for i, deploy := range plan.Deploys {
-			input := &zeetv1.DeploymentConfigurationInput{
-				DefaultWorkflowSteps: lo.Map(deploy.DefaultWorkflowSteps, func(s types.String, _ int) zeetv1.BlueprintDriverWorkflowStepAction {
-					return zeetv1.BlueprintDriverWorkflowStepAction(s.ValueString())
-				}),
+		// Update Logic, deploys removed from the configuration are deleted before the new ones are created
+		for name, deploy := range state.Deploys {
+			if _, ok := plan.Deploys[name]; ok {
+				continue
 			}
-
-			if !deploy.RequirePlanApproval.IsNull() {
-				input.RequirePlanApproval = lo.ToPtr(deploy.RequirePlanApproval.ValueBool())
+			if err := deleteDeployMutation(ctx, r.client.ClientV1(), deploy.Id.ValueUUID()); err != nil {
+				addClientError(&resp.Diagnostics, "delete deploy", err)
+				return
 			}
-
-			if !deploy.Helm.IsNull() {
-				input.Helm = &zeetv1.DeploymentConfigurationKubernetesHelmInput{}
-				if err := json.Unmarshal([]byte(deploy.Helm.ValueString()), &input.Helm); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal helm, got error: %s", err))
-					return
-				}
+		}
+		for _, name := range sortedDeployNames(plan.Deploys) {
+			deploy := plan.Deploys[name]
+			input, diags := deploy.toInput()
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
 			}
 
-			if !deploy.Kubernetes.IsNull() {
-				input.Kubernetes = &zeetv1.DeploymentConfigurationKubernetesInput{}
-				if err := json.Unmarshal([]byte(deploy.Kubernetes.ValueString()), &input.Kubernetes); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal kubernetes, got error: %s", err))
+			if prev, ok := state.Deploys[name]; ok {
+				prevInput, diags := prev.toInput()
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
 					return
 				}
-			}
-
-			if !deploy.Terraform.IsNull() {
-				input.Terraform = &zeetv1.DeploymentConfigurationTerraformInput{}
-				if err := json.Unmarshal([]byte(deploy.Terraform.ValueString()), &input.Terraform); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal terraform, got error: %s", err))
+				rollout = rollout || !reflect.DeepEqual(input, prevInput)
+				if _, err := zeetv1.UpdateDeployMutation(ctx, r.client.ClientV1(), prev.Id.ValueUUID(), zeetv1.UpdateDeployInput{
+					Configuration: input,
+				}); err != nil {
+					addClientError(&resp.Diagnostics, "update deploy", err)
 					return
 				}
-			}
-
-			if !deploy.Variables.IsNull() {
-				input.Variables = []zeetv1.BlueprintVariableInput{}
-				if err := json.Unmarshal([]byte(deploy.Variables.ValueString()), &input.Variables); err != nil {
-					resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal variables, got error: %s", err))
+				deploy.Id = prev.Id
+			} else {
+				created, err := createDeployMutation(ctx, r.client.ClientV1(), createDeployInput{
+					ProjectId:     state.Id.ValueUUID(),
+					Configuration: input,
+				})
+				if err != nil {
+					addClientError(&resp.Diagnostics, "create deploy", err)
 					return
 				}
+				deploy.Id = customtypes.NewUUIDValue(created.Id)
+				rollout = true
 			}
-
-			if _, err := zeetv1.UpdateDeployMutation(ctx, r.client.ClientV1(), state.Deploys[i].Id.ValueUUID(), zeetv1.UpdateDeployInput{
-				Configuration: input,
-			}); err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update deploy, got error: %s", err))
+			plan.Deploys[name] = deploy
+		}
+		if len(plan.Deploys) > 0 {
+			readResult, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), state.TeamId.ValueUUID(), state.Id.ValueUUID())
+			if err != nil {
+				addClientError(&resp.Diagnostics, "read project", err)
 				return
 			}
+			for _, deploy := range plan.Deploys {
+				deploy.readComputed(findDeploy(readResult.Team.Project.Deploys.Nodes, deploy.Id.ValueUUID()))
+			}
 		}
 
 		if !plan.Workflow.Steps.Equal(state.Workflow.Steps) {
@@ -1075,9 +1216,10 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 				Definition: input,
 			})
 			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
+				addClientError(&resp.Diagnostics, "update project", err)
 				return
 			}
+			rollout = true
 		}
 
 		// Toggle deployment last
@@ -1085,13 +1227,14 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 			if plan.Enabled.ValueBool() {
 				_, err := zeetv1.SubmitWorkflowRunMutation(ctx, r.client.ClientV1(), state.Workflow.Id.ValueUUID(), nil)
 				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resume project, got error: %s", err))
+					addClientError(&resp.Diagnostics, "resume project", err)
 					return
 				}
+				rollout = true
 			} else {
 				_, err := zeetv1.DeleteProjectResourcesMutation(ctx, r.client.ClientV1(), state.Id.ValueUUID())
 				if err != nil {
-					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pause project, got error: %s", err))
+					addClientError(&resp.Diagnostics, "pause project", err)
 					return
 				}
 			}
@@ -1103,7 +1246,12 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForRollout(ctx, &plan, previousRollout, rollout, &resp.Diagnostics)
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1116,17 +1264,31 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.IsContainer() {
 		_, err := zeetv0.DeleteProjectMutation(ctx, r.client.Client(), data.Container.RepoId.ValueUUID().String())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
-			return
+			if apierrors.IsNotFound(err) {
+				resp.Diagnostics.AddWarning("Client Error", "Project not found, assuming it has been deleted")
+			} else {
+				addClientError(&resp.Diagnostics, "delete project", err)
+				return
+			}
 		}
 	} else if data.IsWorkflow() {
 		_, err := zeetv1.DeleteProjectMutation(ctx, r.client.ClientV1(), data.Id.ValueUUID(), lo.ToPtr(false))
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
-			return
+			if apierrors.IsNotFound(err) {
+				resp.Diagnostics.AddWarning("Client Error", "Project not found, assuming it has been deleted")
+			} else {
+				addClientError(&resp.Diagnostics, "delete project", err)
+				return
+			}
 		}
 	} else {
 		// Not valid
@@ -1136,5 +1298,166 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	teamId, projectId, err := r.resolveImportId(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import project, got error: %s", err))
+		return
+	}
+
+	readResult, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), teamId, projectId)
+	if apierrors.IsNotFound(err) || err == nil && (readResult.Team == nil || readResult.Team.Project == nil) {
+		resp.Diagnostics.AddError("Cannot import non-existent remote object", fmt.Sprintf("Project %s not found in team %s", projectId, teamId))
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read project", err)
+		return
+	}
+	project := readResult.Team.Project
+	if project.Group == nil || project.SubGroup == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", "project group is missing"))
+		return
+	}
+
+	data := ProjectResourceModel{
+		TeamId:     customtypes.NewUUIDValue(teamId),
+		GroupId:    customtypes.NewUUIDValue(project.Group.Id),
+		SubGroupId: customtypes.NewUUIDValue(project.SubGroup.Id),
+		Id:         customtypes.NewUUIDValue(project.Id),
+		Name:       types.StringValue(project.Name),
+		Timeouts:   nullTimeouts(),
+	}
+	if project.Blueprint != nil {
+		data.BlueprintId = customtypes.NewUUIDValue(project.Blueprint.Id)
+	}
+
+	// container projects are backed by a v0 repo, Read fills in the rest of the configuration
+	pv3Result, err := zeetv0.ProjectV3Query(ctx, r.client.Client(), teamId.String(), project.Group.Name, project.SubGroup.Name, project.Name)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read project", err)
+		return
+	}
+	if pv3Result.User.ProjectV3Adapters != nil && len(pv3Result.User.ProjectV3Adapters.Nodes) > 0 &&
+		pv3Result.User.ProjectV3Adapters.Nodes[0].Repo != nil {
+		data.Container = &ProjectContainerModel{
+			RepoId: customtypes.NewUUIDValue(uuid.MustParse(pv3Result.User.ProjectV3Adapters.Nodes[0].Repo.Id)),
+		}
+	} else if project.Workflow != nil && len(project.Deploys.Nodes) > 0 {
+		steps, err := readWorkflowSteps(ctx, r.client.ClientV1(), teamId, projectId, jsontypes.NewNormalizedNull())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read project", err)
+			return
+		}
+		data.Workflow = &ProjectWorkflowModel{
+			Id:    customtypes.NewUUIDValue(project.Workflow.Id),
+			Steps: steps,
+		}
+		// imported deploys are keyed by their name in Zeet
+		data.Deploys = lo.SliceToMap(project.Deploys.Nodes, func(d zeetv1.ProjectDetailDeploysDeployConnectionNodesDeploy) (string, ProjectDeployModel) {
+			return d.Name, ProjectDeployModel{
+				Id: customtypes.NewUUIDValue(d.Id),
+			}
+		})
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import project, got error: %s", "project is neither a container nor a workflow project"))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveImportId accepts either `team/project_id` or `team/group/subgroup/project`, where every part can be a name or a UUID
+// except for the project identifier of the short form.
+func (r *ProjectResource) resolveImportId(ctx context.Context, id string) (uuid.UUID, uuid.UUID, error) {
+	if strings.Count(id, "/") == 1 {
+		parts, err := splitImportId(id, "team/project_id")
+		if err != nil {
+			return uuid.Nil, uuid.Nil, err
+		}
+		teamId, err := resolveTeamId(ctx, r.client, parts[0])
+		if err != nil {
+			return uuid.Nil, uuid.Nil, err
+		}
+		projectId, err := uuid.Parse(parts[1])
+		if err != nil {
+			return uuid.Nil, uuid.Nil, fmt.Errorf("unable to parse project_id: %w", err)
+		}
+		return teamId, projectId, nil
+	}
+
+	parts, err := splitImportId(id, "team/group/subgroup/project")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	teamId, err := resolveTeamId(ctx, r.client, parts[0])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	groupId, groupName, err := resolveGroup(ctx, r.client, teamId, parts[1])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	_, subGroupName, err := resolveSubGroup(ctx, r.client, teamId, groupId, parts[2])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	projectId, err := resolveProjectId(ctx, r.client, teamId, groupName, subGroupName, parts[3])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return teamId, projectId, nil
+}
+
+// pruneJSON marshals value and drops the object keys that are not set in reference,
+// so that server side defaults the configuration doesn't manage don't show up as a diff.
+func pruneJSON(value any, reference string) (string, error) {
+	valueJson, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	var v, ref any
+	if err := json.Unmarshal(valueJson, &v); err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal([]byte(reference), &ref); err != nil {
+		return "", err
+	}
+
+	prunedJson, err := json.Marshal(pruneJSONValue(v, ref))
+	if err != nil {
+		return "", err
+	}
+	return string(prunedJson), nil
+}
+
+func pruneJSONValue(value, reference any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		ref, ok := reference.(map[string]any)
+		if !ok {
+			return v
+		}
+		for key := range v {
+			if _, ok := ref[key]; !ok {
+				delete(v, key)
+				continue
+			}
+			v[key] = pruneJSONValue(v[key], ref[key])
+		}
+		return v
+	case []any:
+		ref, ok := reference.([]any)
+		if !ok {
+			return v
+		}
+		for i := range v {
+			if i < len(ref) {
+				v[i] = pruneJSONValue(v[i], ref[i])
+			}
+		}
+		return v
+	default:
+		return v
+	}
 }
@@ -0,0 +1,677 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
+)
+
+// ProjectContainerKubernetesModel mirrors zeetv0.ResourceKubernetesInput.
+type ProjectContainerKubernetesModel struct {
+	DeployTarget ProjectContainerKubernetesDeployTargetModel `tfsdk:"deploy_target"`
+	Namespace    types.String                                `tfsdk:"namespace"`
+	App          *ProjectContainerKubernetesAppModel         `tfsdk:"app"`
+}
+
+type ProjectContainerKubernetesDeployTargetModel struct {
+	DeployTarget types.String          `tfsdk:"deploy_target"`
+	ClusterId    customtypes.UUIDValue `tfsdk:"cluster_id"`
+}
+
+type ProjectContainerKubernetesAppModel struct {
+	DeployService        types.Bool                                `tfsdk:"deploy_service"`
+	DeployJob            types.Bool                                `tfsdk:"deploy_job"`
+	UseHumanReadableName types.Bool                                `tfsdk:"use_human_readable_name"`
+	Ports                []ProjectContainerKubernetesPortModel     `tfsdk:"ports"`
+	Resources            *ProjectContainerKubernetesResourcesModel `tfsdk:"resources"`
+	Volumes              []ProjectContainerKubernetesVolumeModel   `tfsdk:"volumes"`
+	Envs                 []ProjectContainerKubernetesEnvModel      `tfsdk:"envs"`
+}
+
+type ProjectContainerKubernetesPortModel struct {
+	Port     types.String `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+	Public   types.Bool   `tfsdk:"public"`
+	Https    types.Bool   `tfsdk:"https"`
+	Grpc     types.Bool   `tfsdk:"grpc"`
+}
+
+type ProjectContainerKubernetesResourcesModel struct {
+	Cpu              types.Float64 `tfsdk:"cpu"`
+	Memory           types.Float64 `tfsdk:"memory"`
+	EphemeralStorage types.Float64 `tfsdk:"ephemeral_storage"`
+	Spot             types.Bool    `tfsdk:"spot"`
+}
+
+type ProjectContainerKubernetesVolumeModel struct {
+	MountPath types.String `tfsdk:"mount_path"`
+	Size      types.Int64  `tfsdk:"size"`
+}
+
+type ProjectContainerKubernetesEnvModel struct {
+	Name   types.String `tfsdk:"name"`
+	Value  types.String `tfsdk:"value"`
+	Sealed types.Bool   `tfsdk:"sealed"`
+}
+
+func projectContainerKubernetesSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Kubernetes configuration for the container deployment, GraphQL type [`ResourceKubernetesInput`](https://docs.zeet.co/0.1.0/graphql/inputs/resource-kubernetes-input/)",
+		Optional:            true,
+		Validators: []validator.Object{
+			objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("kubernetes")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"deploy_target": schema.SingleNestedAttribute{
+				MarkdownDescription: "Deploy target of the container",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"deploy_target": schema.StringAttribute{
+						MarkdownDescription: "Deploy target type, only `KUBERNETES` is supported",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(zeetv0.DeployTargetKubernetes)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(zeetv0.DeployTargetKubernetes)),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplaceIf(deployTargetRequiresReplace(func(v *zeetv0.ProjectDeployInput) string { return string(v.DeployTarget) }),
+								"Changing the deploy target requires replacing the project",
+								"Changing the deploy target requires replacing the project"),
+						},
+					},
+					"cluster_id": schema.StringAttribute{
+						MarkdownDescription: "Cluster identifier, changing it forces a new project",
+						Required:            true,
+						CustomType:          customtypes.UUIDType{},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplaceIf(deployTargetRequiresReplace(func(v *zeetv0.ProjectDeployInput) string {
+								if v.ClusterID == nil {
+									return ""
+								}
+								return v.ClusterID.String()
+							}),
+								"Changing the cluster requires replacing the project",
+								"Changing the cluster requires replacing the project"),
+						},
+					},
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Kubernetes namespace, defaults to the namespace chosen by Zeet",
+				Optional:            true,
+			},
+			"app": schema.SingleNestedAttribute{
+				MarkdownDescription: "Application configuration",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"deploy_service": schema.BoolAttribute{
+						MarkdownDescription: "Indicates if the container is deployed as a long running service",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"deploy_job": schema.BoolAttribute{
+						MarkdownDescription: "Indicates if the container is deployed as a job",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"use_human_readable_name": schema.BoolAttribute{
+						MarkdownDescription: "Indicates if the Kubernetes resources use a human readable name",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"ports": schema.ListNestedAttribute{
+						MarkdownDescription: "Exposed ports",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.StringAttribute{
+									MarkdownDescription: "Port number",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a port number"),
+									},
+								},
+								"protocol": schema.StringAttribute{
+									MarkdownDescription: "Port protocol, either `tcp` or `udp`",
+									Optional:            true,
+									Computed:            true,
+									Default:             stringdefault.StaticString(string(zeetv0.PortProtocolTcp)),
+									Validators: []validator.String{
+										stringvalidator.OneOf(string(zeetv0.PortProtocolTcp), string(zeetv0.PortProtocolUdp)),
+									},
+								},
+								"public": schema.BoolAttribute{
+									MarkdownDescription: "Indicates if the port is exposed to the internet",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+								"https": schema.BoolAttribute{
+									MarkdownDescription: "Indicates if HTTPS is enabled for the port",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+								"grpc": schema.BoolAttribute{
+									MarkdownDescription: "Indicates if the port serves gRPC",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+							},
+						},
+					},
+					"resources": schema.SingleNestedAttribute{
+						MarkdownDescription: "Container resources",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"cpu": schema.Float64Attribute{
+								MarkdownDescription: "CPU in cores",
+								Required:            true,
+								Validators: []validator.Float64{
+									float64validator.AtLeast(0),
+								},
+							},
+							"memory": schema.Float64Attribute{
+								MarkdownDescription: "Memory in GB",
+								Required:            true,
+								Validators: []validator.Float64{
+									float64validator.AtLeast(0),
+								},
+							},
+							"ephemeral_storage": schema.Float64Attribute{
+								MarkdownDescription: "Ephemeral storage in GB",
+								Optional:            true,
+								Validators: []validator.Float64{
+									float64validator.AtLeast(0),
+								},
+							},
+							"spot": schema.BoolAttribute{
+								MarkdownDescription: "Indicates if the container runs on spot instances",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+						},
+					},
+					"volumes": schema.ListNestedAttribute{
+						MarkdownDescription: "Persistent volumes",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"mount_path": schema.StringAttribute{
+									MarkdownDescription: "Mount path in the container",
+									Required:            true,
+								},
+								"size": schema.Int64Attribute{
+									MarkdownDescription: "Volume size in GB",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+					"envs": schema.ListNestedAttribute{
+						MarkdownDescription: "Environment variables",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Variable name",
+									Required:            true,
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Variable value",
+									Required:            true,
+									Sensitive:           true,
+								},
+								"sealed": schema.BoolAttribute{
+									MarkdownDescription: "Indicates if the value is hidden after it is set",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (m *ProjectContainerKubernetesModel) toInput() *zeetv0.ResourceKubernetesInput {
+	input := &zeetv0.ResourceKubernetesInput{
+		DeployTarget: &zeetv0.ProjectDeployInput{
+			DeployTarget: zeetv0.DeployTarget(m.DeployTarget.DeployTarget.ValueString()),
+			ClusterID:    lo.ToPtr(m.DeployTarget.ClusterId.ValueUUID()),
+		},
+	}
+	if !m.Namespace.IsNull() {
+		input.Namespace = lo.ToPtr(m.Namespace.ValueString())
+	}
+	if m.App == nil {
+		return input
+	}
+
+	input.App = &zeetv0.ResourceKubernetesAppInput{
+		DeployService:        lo.ToPtr(m.App.DeployService.ValueBool()),
+		DeployJob:            lo.ToPtr(m.App.DeployJob.ValueBool()),
+		UseHumanReadableName: lo.ToPtr(m.App.UseHumanReadableName.ValueBool()),
+		Ports: lo.Map(m.App.Ports, func(p ProjectContainerKubernetesPortModel, _ int) zeetv0.PortInput {
+			return zeetv0.PortInput{
+				Port:     p.Port.ValueString(),
+				Protocol: zeetv0.PortProtocol(p.Protocol.ValueString()),
+				Public:   p.Public.ValueBool(),
+				Https:    p.Https.ValueBool(),
+				Grpc:     lo.ToPtr(p.Grpc.ValueBool()),
+			}
+		}),
+		Volumes: lo.Map(m.App.Volumes, func(v ProjectContainerKubernetesVolumeModel, _ int) zeetv0.VolumeInput {
+			return zeetv0.VolumeInput{
+				MountPath: v.MountPath.ValueString(),
+				Size:      int(v.Size.ValueInt64()),
+			}
+		}),
+		Envs: lo.Map(m.App.Envs, func(e ProjectContainerKubernetesEnvModel, _ int) zeetv0.EnvVarInput {
+			return zeetv0.EnvVarInput{
+				Name:   e.Name.ValueString(),
+				Value:  e.Value.ValueString(),
+				Sealed: lo.ToPtr(e.Sealed.ValueBool()),
+			}
+		}),
+	}
+
+	if m.App.Resources != nil {
+		input.App.Resources = &zeetv0.ContainerResourcesSpecInput{
+			Cpu:    m.App.Resources.Cpu.ValueFloat64(),
+			Memory: m.App.Resources.Memory.ValueFloat64(),
+			Spot:   lo.ToPtr(m.App.Resources.Spot.ValueBool()),
+		}
+		if !m.App.Resources.EphemeralStorage.IsNull() {
+			input.App.Resources.EphemeralStorage = lo.ToPtr(m.App.Resources.EphemeralStorage.ValueFloat64())
+		}
+	}
+
+	return input
+}
+
+// newProjectContainerKubernetesModel maps the repo back to the typed kubernetes configuration.
+// Optional attributes without a server side default are only read when the prior state manages them,
+// prev is nil when there is no prior state (e.g. import).
+func newProjectContainerKubernetesModel(repo *zeetv0.UserRepoCurrentUserRepo, prev *ProjectContainerKubernetesModel) (*ProjectContainerKubernetesModel, error) {
+	model := &ProjectContainerKubernetesModel{
+		DeployTarget: ProjectContainerKubernetesDeployTargetModel{
+			DeployTarget: types.StringValue(string(*repo.DeployTarget)),
+		},
+	}
+	if repo.Cluster != nil {
+		model.DeployTarget.ClusterId = customtypes.NewUUIDValue(repo.Cluster.Id)
+	}
+	if repo.Namespace != nil && (prev == nil || !prev.Namespace.IsNull()) {
+		model.Namespace = types.StringValue(*repo.Namespace)
+	}
+
+	if prev != nil && prev.App == nil {
+		return model, nil
+	}
+	var prevApp ProjectContainerKubernetesAppModel
+	if prev != nil {
+		prevApp = *prev.App
+	}
+
+	model.App = &ProjectContainerKubernetesAppModel{
+		DeployService:        types.BoolValue(lo.FromPtr(repo.DeployService)),
+		DeployJob:            types.BoolValue(lo.FromPtr(repo.DeployJob)),
+		UseHumanReadableName: types.BoolValue(lo.FromPtrOr(repo.UseHumanReadableKubernetesName, true)),
+	}
+
+	if len(repo.Ports) > 0 || prevApp.Ports != nil {
+		model.App.Ports = lo.Map(repo.Ports, func(p zeetv0.RepoNetworkPortsPort, _ int) ProjectContainerKubernetesPortModel {
+			return ProjectContainerKubernetesPortModel{
+				Port:     types.StringValue(p.Port),
+				Protocol: types.StringValue(p.Protocol),
+				Public:   types.BoolValue(p.Public),
+				Https:    types.BoolValue(p.Https),
+				Grpc:     types.BoolValue(p.Grpc),
+			}
+		})
+	}
+
+	if repo.Cpu != nil && repo.Memory != nil && *repo.Cpu != "" && *repo.Memory != "" &&
+		(prev == nil || prevApp.Resources != nil) {
+		cpu, err := parseContainerCpu(*repo.Cpu)
+		if err != nil {
+			return nil, err
+		}
+		memory, err := parseContainerMemory(*repo.Memory)
+		if err != nil {
+			return nil, err
+		}
+		model.App.Resources = &ProjectContainerKubernetesResourcesModel{
+			Cpu:    types.Float64Value(cpu),
+			Memory: types.Float64Value(memory),
+			Spot:   types.BoolValue(repo.Dedicated != nil && !*repo.Dedicated),
+		}
+		if repo.EphemeralStorage != nil && (prev == nil || !prevApp.Resources.EphemeralStorage.IsNull()) {
+			model.App.Resources.EphemeralStorage = types.Float64Value(*repo.EphemeralStorage)
+		}
+	}
+
+	if len(repo.Volumes) > 0 || prevApp.Volumes != nil {
+		model.App.Volumes = lo.Map(repo.Volumes, func(v zeetv0.RepoDetailVolumesVolumeSpec, _ int) ProjectContainerKubernetesVolumeModel {
+			return ProjectContainerKubernetesVolumeModel{
+				MountPath: types.StringValue(v.MountPath),
+				Size:      types.Int64Value(int64(v.Size)),
+			}
+		})
+	}
+
+	if len(repo.Envs) > 0 || prevApp.Envs != nil {
+		prevEnvs := lo.KeyBy(prevApp.Envs, func(e ProjectContainerKubernetesEnvModel) string {
+			return e.Name.ValueString()
+		})
+		model.App.Envs = lo.Map(repo.Envs, func(e zeetv0.RepoDetailEnvsEnvVar, _ int) ProjectContainerKubernetesEnvModel {
+			env := ProjectContainerKubernetesEnvModel{
+				Name:   types.StringValue(e.Name),
+				Value:  types.StringValue(e.Value),
+				Sealed: types.BoolValue(e.Sealed),
+			}
+			// sealed values are not returned by the API
+			if prevEnv, ok := prevEnvs[e.Name]; ok && e.Sealed {
+				env.Value = prevEnv.Value
+			}
+			return env
+		})
+		// keep the configured order to avoid spurious diffs
+		order := lo.Map(prevApp.Envs, func(e ProjectContainerKubernetesEnvModel, _ int) string {
+			return e.Name.ValueString()
+		})
+		sort.SliceStable(model.App.Envs, func(i, j int) bool {
+			return envOrder(order, model.App.Envs[i].Name.ValueString()) < envOrder(order, model.App.Envs[j].Name.ValueString())
+		})
+	}
+
+	return model, nil
+}
+
+// memoryUnits are the bytes of the Kubernetes quantity suffixes the API returns the memory with, a bare number is in GB.
+var memoryUnits = map[string]float64{
+	"":   1e9,
+	"K":  1e3,
+	"Ki": 1 << 10,
+	"M":  1e6,
+	"Mi": 1 << 20,
+	"G":  1e9,
+	"Gi": 1 << 30,
+	"T":  1e12,
+	"Ti": 1 << 40,
+}
+
+var quantityRegexp = regexp.MustCompile(`^([0-9.]+)([a-zA-Z]*)$`)
+
+// parseContainerCpu returns the cores of a CPU quantity such as `1`, `0.5` or `500m`.
+func parseContainerCpu(cpu string) (float64, error) {
+	if millis, ok := strings.CutSuffix(cpu, "m"); ok {
+		value, err := strconv.ParseFloat(millis, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid cpu %q: %w", cpu, err)
+		}
+		return value / 1000, nil
+	}
+	value, err := strconv.ParseFloat(cpu, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cpu %q: %w", cpu, err)
+	}
+	return value, nil
+}
+
+// parseContainerMemory returns the GB of a memory quantity such as `1`, `1G`, `512Mi` or `0.5Gi`.
+func parseContainerMemory(memory string) (float64, error) {
+	match := quantityRegexp.FindStringSubmatch(memory)
+	if match == nil {
+		return 0, fmt.Errorf("invalid memory %q", memory)
+	}
+	unit, ok := memoryUnits[match[2]]
+	if !ok {
+		return 0, fmt.Errorf("invalid memory %q: unknown unit %q", memory, match[2])
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory %q: %w", memory, err)
+	}
+	return value * unit / 1e9, nil
+}
+
+func envOrder(order []string, name string) int {
+	if i := lo.IndexOf(order, name); i >= 0 {
+		return i
+	}
+	return len(order)
+}
+
+// newResourceBuildInput maps the repo build settings back to the build JSON configuration.
+// Only the keys set in prev are kept, fields that can't be read from the API are copied from prev.
+// prev is null when there is no prior state (e.g. import), every field read from the API is kept then.
+func newResourceBuildInput(repo *zeetv0.UserRepoCurrentUserRepo, prev jsontypes.Normalized) (jsontypes.Normalized, error) {
+	prevInput := zeetv0.ResourceBuildInput{}
+	if !prev.IsNull() {
+		if err := json.Unmarshal([]byte(prev.ValueString()), &prevInput); err != nil {
+			return prev, err
+		}
+	}
+
+	input := zeetv0.ResourceBuildInput{
+		BuildTarget:              prevInput.BuildTarget,
+		GitSubmodules:            repo.GitSubmodules,
+		NoBuildCache:             repo.NoBuildCache,
+		ContainerRepository:      prevInput.ContainerRepository,
+		ContainerCacheRepository: prevInput.ContainerCacheRepository,
+	}
+	if repo.BuildMethod != nil {
+		input.Build = &zeetv0.ProjectBuildInput{
+			BuildType:        &repo.BuildMethod.Type,
+			DockerfilePath:   repo.BuildMethod.DockerfilePath,
+			WorkingDirectory: repo.BuildMethod.WorkingDirectory,
+			BuildCommand:     repo.BuildMethod.BuildCommand,
+			RunCommand:       repo.BuildMethod.RunCommand,
+			StaticPath:       repo.BuildMethod.StaticPath,
+			NodejsVersion:    repo.BuildMethod.NodejsVersion,
+			PythonVersion:    repo.BuildMethod.PythonVersion,
+			GolangVersion:    repo.BuildMethod.GolangVersion,
+		}
+	}
+	if repo.BuildResources != nil && repo.BuildResources.Cpu != nil && repo.BuildResources.Memory != nil {
+		input.BuildResources = &zeetv0.ContainerResourcesSpecInput{
+			Cpu:              *repo.BuildResources.Cpu,
+			Memory:           *repo.BuildResources.Memory,
+			EphemeralStorage: repo.BuildResources.EphemeralStorage,
+			Spot:             repo.BuildResources.Spot,
+		}
+	}
+	if repo.KanikoFlags != nil {
+		input.KanikoFlags = &zeetv0.KanikoFlagsInput{
+			CompressedCaching: repo.KanikoFlags.CompressedCaching,
+		}
+	}
+	if repo.ContainerRegistry != nil {
+		input.ContainerRegistryID = &repo.ContainerRegistry.Id
+	}
+
+	if prev.IsNull() {
+		value, err := json.Marshal(input)
+		if err != nil {
+			return prev, err
+		}
+		return jsontypes.NewNormalizedValue(string(value)), nil
+	}
+
+	value, err := pruneJSON(input, prev.ValueString())
+	if err != nil {
+		return prev, err
+	}
+	return jsontypes.NewNormalizedValue(value), nil
+}
+
+func setUpdateProjectBuildInput(input *zeetv0.UpdateProjectInput, build zeetv0.ResourceBuildInput) {
+	if build.Build != nil {
+		if build.Build.BuildType != nil {
+			input.BuildType = lo.ToPtr(string(*build.Build.BuildType))
+		}
+		input.DockerfilePath = build.Build.DockerfilePath
+		input.WorkingDirectory = build.Build.WorkingDirectory
+		input.BuildCommand = build.Build.BuildCommand
+		input.RunCommand = build.Build.RunCommand
+		input.StaticPath = build.Build.StaticPath
+		input.NodejsVersion = build.Build.NodejsVersion
+		input.PythonVersion = build.Build.PythonVersion
+		input.GolangVersion = build.Build.GolangVersion
+	}
+	input.BuildResources = build.BuildResources
+	input.GitSubmodules = build.GitSubmodules
+	input.KanikoFlags = build.KanikoFlags
+	input.NoBuildCache = build.NoBuildCache
+	input.ContainerRegistryID = build.ContainerRegistryID
+	input.ContainerRepository = build.ContainerRepository
+	input.ContainerCacheRepository = build.ContainerCacheRepository
+}
+
+// jsonFieldRequiresReplace replaces the project when the field selected from the JSON configuration changes,
+// for fields that can't be updated in place.
+func jsonFieldRequiresReplace[T any](field func(T) any) stringplanmodifier.RequiresReplaceIfFunc {
+	return func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+			return
+		}
+
+		var state, plan T
+		if err := json.Unmarshal([]byte(req.StateValue.ValueString()), &state); err != nil {
+			return
+		}
+		if err := json.Unmarshal([]byte(req.PlanValue.ValueString()), &plan); err != nil {
+			return
+		}
+		resp.RequiresReplace = !reflect.DeepEqual(field(state), field(plan))
+	}
+}
+
+// deployTargetRequiresReplace replaces the project when a deploy target field of kubernetes_config changes.
+// A project moving from the deprecated kubernetes JSON has no prior kubernetes_config, the field is compared with
+// the deploy target of the JSON instead.
+func deployTargetRequiresReplace(field func(*zeetv0.ProjectDeployInput) string) stringplanmodifier.RequiresReplaceIfFunc {
+	return func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+			return
+		}
+		if !req.StateValue.IsNull() {
+			resp.RequiresReplace = req.StateValue.ValueString() != req.PlanValue.ValueString()
+			return
+		}
+
+		var legacy jsontypes.Normalized
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("container").AtName("kubernetes"), &legacy)...)
+		if resp.Diagnostics.HasError() || legacy.IsNull() || legacy.IsUnknown() {
+			return
+		}
+		var input zeetv0.ResourceKubernetesInput
+		if err := json.Unmarshal([]byte(legacy.ValueString()), &input); err != nil || input.DeployTarget == nil {
+			return
+		}
+		if value := field(input.DeployTarget); value != "" {
+			resp.RequiresReplace = !strings.EqualFold(value, req.PlanValue.ValueString())
+		}
+	}
+}
+
+// kubernetesInput returns the kubernetes configuration from either kubernetes_config or the legacy kubernetes JSON.
+func (m *ProjectContainerModel) kubernetesInput() (*zeetv0.ResourceKubernetesInput, error) {
+	if m.KubernetesConfig != nil {
+		return m.KubernetesConfig.toInput(), nil
+	}
+	if m.Kubernetes.IsNull() || m.Kubernetes.IsUnknown() {
+		return nil, nil
+	}
+
+	input := &zeetv0.ResourceKubernetesInput{}
+	if err := json.Unmarshal([]byte(m.Kubernetes.ValueString()), input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// setUpdateProjectKubernetesInput sets the app settings that changed between state and plan.
+// Namespace, human readable name and envs are updated through their own mutations.
+func setUpdateProjectKubernetesInput(input *zeetv0.UpdateProjectInput, plan, state zeetv0.ResourceKubernetesAppInput) error {
+	if !reflect.DeepEqual(plan.DeployService, state.DeployService) {
+		input.DeployService = plan.DeployService
+	}
+	if !reflect.DeepEqual(plan.DeployJob, state.DeployJob) {
+		input.DeployJob = plan.DeployJob
+	}
+	if !reflect.DeepEqual(plan.Ports, state.Ports) {
+		ports, err := json.Marshal(lo.Ternary(plan.Ports == nil, []zeetv0.PortInput{}, plan.Ports))
+		if err != nil {
+			return err
+		}
+		input.Ports = lo.ToPtr(string(ports))
+	}
+	if !reflect.DeepEqual(plan.Volumes, state.Volumes) {
+		volumes, err := json.Marshal(lo.Ternary(plan.Volumes == nil, []zeetv0.VolumeInput{}, plan.Volumes))
+		if err != nil {
+			return err
+		}
+		input.Volumes = lo.ToPtr(string(volumes))
+	}
+	if plan.Resources != nil && !reflect.DeepEqual(plan.Resources, state.Resources) {
+		input.Resources = plan.Resources
+		if plan.Resources.Spot != nil {
+			input.Dedicated = lo.ToPtr(!*plan.Resources.Spot)
+		}
+	}
+	return nil
+}
+
+// stringValueOrNull keeps a setting null when it is unconfigured and the API reports it as empty.
+func stringValueOrNull(value string, prev types.String) types.String {
+	if value == "" && prev.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// boolValueOrNull keeps a setting null when it is unconfigured and the API reports it as false.
+func boolValueOrNull(value bool, prev types.Bool) types.Bool {
+	if !value && prev.IsNull() {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}
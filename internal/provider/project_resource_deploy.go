@@ -0,0 +1,1198 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
+)
+
+// toInput returns the configuration input of the deploy.
+func (d *ProjectDeployModel) toInput() (*zeetv1.DeploymentConfigurationInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	input := &zeetv1.DeploymentConfigurationInput{
+		DefaultWorkflowSteps: lo.Map(d.DefaultWorkflowSteps, func(s types.String, _ int) zeetv1.BlueprintDriverWorkflowStepAction {
+			return zeetv1.BlueprintDriverWorkflowStepAction(s.ValueString())
+		}),
+	}
+
+	if !d.RequirePlanApproval.IsNull() {
+		input.RequirePlanApproval = lo.ToPtr(d.RequirePlanApproval.ValueBool())
+	}
+
+	helmInput, err := d.helmInput()
+	if err != nil {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal helm, got error: %s", err))
+		return nil, diags
+	}
+	input.Helm = helmInput
+
+	kubernetesInput, err := d.kubernetesInput()
+	if err != nil {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal kubernetes, got error: %s", err))
+		return nil, diags
+	}
+	input.Kubernetes = kubernetesInput
+
+	terraformInput, err := d.terraformInput()
+	if err != nil {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal terraform, got error: %s", err))
+		return nil, diags
+	}
+	input.Terraform = terraformInput
+
+	if !d.Variables.IsNull() {
+		input.Variables = []zeetv1.BlueprintVariableInput{}
+		if err := json.Unmarshal([]byte(d.Variables.ValueString()), &input.Variables); err != nil {
+			diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal variables, got error: %s", err))
+			return nil, diags
+		}
+	}
+
+	return input, diags
+}
+
+// read updates the deploy from its configuration in Zeet, the prior state decides which attributes are read,
+// see newProjectDeployHelmModel.
+func (d *ProjectDeployModel) read(deploy *zeetv1.DeployConfigurationDetail) diag.Diagnostics {
+	var diags diag.Diagnostics
+	prev := *d
+	imported := prev.imported()
+	d.Id = customtypes.NewUUIDValue(deploy.Id)
+	if deploy.Configuration == nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", "deploy configuration is missing"))
+		return diags
+	}
+	d.DefaultWorkflowSteps = lo.Map(deploy.Configuration.DefaultWorkflowSteps, func(s zeetv1.BlueprintDriverWorkflowStepAction, _ int) types.String {
+		return types.StringValue(string(s))
+	})
+	if deploy.Configuration.RequirePlanApproval != nil {
+		d.RequirePlanApproval = types.BoolValue(*deploy.Configuration.RequirePlanApproval)
+	}
+	if deploy.Configuration.Variables != nil && len(deploy.Configuration.Variables) > 0 {
+		valJson, err := json.Marshal(deploy.Configuration.Variables)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", err))
+			return diags
+		}
+		input := []zeetv1.BlueprintVariableInput{}
+		if err := json.Unmarshal(valJson, &input); err != nil {
+			diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to unmarshal variables, got error: %s", err))
+			return diags
+		}
+		for j := range input {
+			input[j].SpecId = nil
+			if deploy.Configuration.Variables[j].ValueString != nil {
+				input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeString)
+				input[j].Value = *deploy.Configuration.Variables[j].ValueString
+			} else if deploy.Configuration.Variables[j].ValueInt != nil {
+				input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeInteger)
+				input[j].Value = strconv.FormatInt(int64(*deploy.Configuration.Variables[j].ValueInt), 10)
+			} else if deploy.Configuration.Variables[j].ValueFloat != nil {
+				input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeFloat)
+				input[j].Value = strconv.FormatFloat(*deploy.Configuration.Variables[j].ValueFloat, 'f', -1, 64)
+			} else if deploy.Configuration.Variables[j].ValueBoolean != nil {
+				input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeBoolean)
+				input[j].Value = strconv.FormatBool(*deploy.Configuration.Variables[j].ValueBoolean)
+			} else if deploy.Configuration.Variables[j].ValueJson != nil {
+				input[j].Type = lo.ToPtr(zeetv1.BlueprintVariableTypeJson)
+				input[j].Value = *deploy.Configuration.Variables[j].ValueJson
+			}
+		}
+		inputJson, err := json.Marshal(input)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", err))
+			return diags
+		}
+		d.Variables = jsontypes.NewNormalizedValue(string(inputJson))
+	}
+	if deploy.Configuration.Kubernetes != nil {
+		input, err := newKubernetesInput(deploy.Configuration.Kubernetes)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", err))
+			return diags
+		}
+		if prev.KubernetesConfig != nil || (imported && input.Generator == nil) {
+			d.KubernetesConfig = newProjectDeployKubernetesModel(input, prev.KubernetesConfig)
+		} else if imported {
+			valJson, err := json.Marshal(input)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", err))
+				return diags
+			}
+			d.Kubernetes = jsontypes.NewNormalizedValue(string(valJson))
+		} else if !prev.Kubernetes.IsNull() {
+			valJson, err := pruneJSON(input, prev.Kubernetes.ValueString())
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", err))
+				return diags
+			}
+			d.Kubernetes = jsontypes.NewNormalizedValue(valJson)
+		}
+	}
+	if deploy.Configuration.Helm != nil {
+		if prev.HelmConfig != nil || imported {
+			d.HelmConfig = newProjectDeployHelmModel(deploy.Configuration.Helm, prev.HelmConfig)
+		} else if !prev.Helm.IsNull() {
+			valJson, err := pruneJSON(deploy.Configuration.Helm, prev.Helm.ValueString())
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", err))
+				return diags
+			}
+			d.Helm = jsontypes.NewNormalizedValue(valJson)
+		}
+	}
+	if deploy.Configuration.Terraform != nil {
+		input := newTerraformInput(deploy.Configuration.Terraform)
+		if prev.TerraformConfig != nil || imported {
+			d.TerraformConfig = newProjectDeployTerraformModel(input, prev.TerraformConfig)
+		} else if !prev.Terraform.IsNull() {
+			valJson, err := pruneJSON(input, prev.Terraform.ValueString())
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read deploy, got error: %s", err))
+				return diags
+			}
+			d.Terraform = jsontypes.NewNormalizedValue(valJson)
+		}
+	}
+
+	return diags
+}
+
+// projectDeployAttributes returns the attributes of a deploy, shared by the deploys of the project resource and the
+// project deploy resource.
+func projectDeployAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Deployment identifier",
+			Computed:            true,
+			CustomType:          customtypes.UUIDType{},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"default_workflow_steps": schema.ListAttribute{
+			MarkdownDescription: "Default workflow steps for deployment list of [step types](https://docs.zeet.co/graphql/enums/blueprint-driver-workflow-step-action/)",
+			Required:            true,
+			ElementType:         types.StringType,
+		},
+		"require_plan_approval": schema.BoolAttribute{
+			MarkdownDescription: "Indicates if the approval step is required in all workflow runs",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"variables": schema.StringAttribute{
+			MarkdownDescription: "Blueprint variables, GraphQL type [`[BlueprintVariableInput]`](https://docs.zeet.co/graphql/inputs/blueprint-variable-input/)",
+			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"kubernetes": schema.StringAttribute{
+			MarkdownDescription: "Kubernetes deployment configuration in [JSON format](https://docs.zeet.co/graphql/inputs/deployment-configuration-kubernetes-input/)",
+			DeprecationMessage:  "Use kubernetes_config instead, this attribute will be removed in the next release",
+			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"kubernetes_config": projectDeployKubernetesSchema(),
+		"helm": schema.StringAttribute{
+			MarkdownDescription: "Helm deployment configuration in [JSON format](https://docs.zeet.co/graphql/inputs/deployment-configuration-kubernetes-helm-input/)",
+			DeprecationMessage:  "Use helm_config instead, this attribute will be removed in the next release",
+			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"helm_config": projectDeployHelmSchema(),
+		"terraform": schema.StringAttribute{
+			MarkdownDescription: "Terraform deployment configuration in [JSON format](https://docs.zeet.co/graphql/inputs/deployment-configuration-terraform-input/)",
+			DeprecationMessage:  "Use terraform_config instead, this attribute will be removed in the next release",
+			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"terraform_config": projectDeployTerraformSchema(),
+	}
+}
+
+// The SDK has no mutations to add a deploy to an existing project or to remove one.
+const createDeployOperation = `
+mutation createDeploy ($input: CreateDeployInput!) {
+	createDeploy(input: $input) {
+		id
+		name
+	}
+}
+`
+
+const deleteDeployOperation = `
+mutation deleteDeploy ($id: UUID!) {
+	deleteDeploy(id: $id)
+}
+`
+
+// createDeployInput mirrors the CreateDeployInput of the API.
+type createDeployInput struct {
+	ProjectId     uuid.UUID                            `json:"projectId"`
+	Configuration *zeetv1.DeploymentConfigurationInput `json:"configuration,omitempty"`
+}
+
+// createdDeploy holds the identifier and the name Zeet generates for a new deploy.
+type createdDeploy struct {
+	Id   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func createDeployMutation(ctx context.Context, client graphql.Client, input createDeployInput) (createdDeploy, error) {
+	var data struct {
+		CreateDeploy createdDeploy `json:"createDeploy"`
+	}
+	err := client.MakeRequest(ctx, &graphql.Request{
+		OpName: "createDeploy",
+		Query:  createDeployOperation,
+		Variables: map[string]any{
+			"input": input,
+		},
+	}, &graphql.Response{Data: &data})
+	return data.CreateDeploy, err
+}
+
+func deleteDeployMutation(ctx context.Context, client graphql.Client, id uuid.UUID) error {
+	var data struct {
+		DeleteDeploy bool `json:"deleteDeploy"`
+	}
+	return client.MakeRequest(ctx, &graphql.Request{
+		OpName: "deleteDeploy",
+		Query:  deleteDeployOperation,
+		Variables: map[string]any{
+			"id": id,
+		},
+	}, &graphql.Response{Data: &data})
+}
+
+// ProjectDeployHelmModel mirrors zeetv1.DeploymentConfigurationKubernetesHelmInput.
+type ProjectDeployHelmModel struct {
+	Chart       *ProjectDeployHelmChartModel `tfsdk:"chart"`
+	ClusterId   customtypes.UUIDValue        `tfsdk:"cluster_id"`
+	Namespace   types.String                 `tfsdk:"namespace"`
+	ReleaseName types.String                 `tfsdk:"release_name"`
+	Values      customtypes.YAMLValue        `tfsdk:"values"`
+}
+
+type ProjectDeployHelmChartModel struct {
+	RepositoryUrl types.String `tfsdk:"repository_url"`
+	Name          types.String `tfsdk:"name"`
+	Version       types.String `tfsdk:"version"`
+}
+
+func projectDeployHelmSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Helm deployment configuration, GraphQL type [`DeploymentConfigurationKubernetesHelmInput`](https://docs.zeet.co/graphql/inputs/deployment-configuration-kubernetes-helm-input/)",
+		Optional:            true,
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("helm")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"chart": schema.SingleNestedAttribute{
+				MarkdownDescription: "Chart from a Helm repository, defaults to the chart of the blueprint",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"repository_url": schema.StringAttribute{
+						MarkdownDescription: "Helm repository URL",
+						Required:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Chart name",
+						Required:            true,
+					},
+					"version": schema.StringAttribute{
+						MarkdownDescription: "Chart version, defaults to the latest version",
+						Optional:            true,
+					},
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Target cluster identifier",
+				Required:            true,
+				CustomType:          customtypes.UUIDType{},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Kubernetes namespace of the release, defaults to the namespace chosen by Zeet",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"release_name": schema.StringAttribute{
+				MarkdownDescription: "Helm release name, defaults to the name chosen by Zeet",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"values": schema.StringAttribute{
+				MarkdownDescription: "Helm values in YAML format",
+				Optional:            true,
+				CustomType:          customtypes.YAMLType{},
+			},
+		},
+	}
+}
+
+func (m *ProjectDeployHelmModel) toInput() *zeetv1.DeploymentConfigurationKubernetesHelmInput {
+	input := &zeetv1.DeploymentConfigurationKubernetesHelmInput{
+		Target: &zeetv1.HelmTargetConfigurationInput{
+			ClusterId:   m.ClusterId.ValueUUID(),
+			Namespace:   lo.Ternary(m.Namespace.IsUnknown(), nil, m.Namespace.ValueStringPointer()),
+			ReleaseName: lo.Ternary(m.ReleaseName.IsUnknown(), nil, m.ReleaseName.ValueStringPointer()),
+		},
+		Values: m.Values.ValueStringPointer(),
+	}
+	if m.Chart != nil {
+		input.Blueprint = &zeetv1.BlueprintHelmConfigurationInput{
+			Source: &zeetv1.SourceInput{
+				HelmRepository: &zeetv1.HelmRepositorySourceInput{
+					RepositoryUrl: m.Chart.RepositoryUrl.ValueString(),
+					Chart:         m.Chart.Name.ValueString(),
+					Version:       m.Chart.Version.ValueStringPointer(),
+				},
+			},
+		}
+	}
+	return input
+}
+
+// newProjectDeployHelmModel maps the deploy back to the typed helm configuration.
+// Optional attributes without a server side default are only read when the prior state manages them,
+// prev is nil when there is no prior state (e.g. import). The namespace and the release name chosen by Zeet are
+// always read.
+func newProjectDeployHelmModel(helm *zeetv1.DeployConfigurationDetailConfigurationDeploymentConfigurationHelmDeploymentConfigurationKubernetesHelm, prev *ProjectDeployHelmModel) *ProjectDeployHelmModel {
+	model := &ProjectDeployHelmModel{
+		Namespace:   types.StringNull(),
+		ReleaseName: types.StringNull(),
+	}
+	if helm.Target != nil {
+		model.ClusterId = customtypes.NewUUIDValue(helm.Target.ClusterId)
+		model.Namespace = types.StringPointerValue(helm.Target.Namespace)
+		model.ReleaseName = types.StringPointerValue(helm.Target.ReleaseName)
+	}
+	if helm.Values != nil && (prev == nil || !prev.Values.IsNull()) {
+		model.Values = customtypes.NewYAMLValue(*helm.Values)
+	}
+
+	if helm.Blueprint != nil && helm.Blueprint.Source != nil && helm.Blueprint.Source.HelmRepository != nil &&
+		(prev == nil || prev.Chart != nil) {
+		repository := helm.Blueprint.Source.HelmRepository
+		model.Chart = &ProjectDeployHelmChartModel{
+			RepositoryUrl: types.StringValue(repository.RepositoryUrl),
+			Name:          types.StringValue(repository.Chart),
+		}
+		if repository.Version != nil && (prev == nil || !prev.Chart.Version.IsNull()) {
+			model.Chart.Version = types.StringValue(*repository.Version)
+		}
+	}
+
+	return model
+}
+
+// readComputed sets the attributes of the deploy chosen by Zeet, they are unknown in the plan until the deploy is
+// created or updated. deploy is nil when the deploy is not found.
+func (d *ProjectDeployModel) readComputed(deploy *zeetv1.DeployConfigurationDetail) {
+	if d.HelmConfig == nil {
+		return
+	}
+
+	var namespace, releaseName *string
+	if deploy != nil && deploy.Configuration != nil && deploy.Configuration.Helm != nil && deploy.Configuration.Helm.Target != nil {
+		namespace, releaseName = deploy.Configuration.Helm.Target.Namespace, deploy.Configuration.Helm.Target.ReleaseName
+	}
+	if d.HelmConfig.Namespace.IsUnknown() {
+		d.HelmConfig.Namespace = types.StringPointerValue(namespace)
+	}
+	if d.HelmConfig.ReleaseName.IsUnknown() {
+		d.HelmConfig.ReleaseName = types.StringPointerValue(releaseName)
+	}
+}
+
+// sortedDeployNames returns the names of the deploys in order, the deploys are created and updated in that order.
+func sortedDeployNames(deploys map[string]ProjectDeployModel) []string {
+	names := lo.Keys(deploys)
+	sort.Strings(names)
+	return names
+}
+
+// findDeploy returns the deploy of the project with the given identifier, or nil.
+func findDeploy(nodes []zeetv1.ProjectDetailDeploysDeployConnectionNodesDeploy, id uuid.UUID) *zeetv1.DeployConfigurationDetail {
+	node, ok := lo.Find(nodes, func(d zeetv1.ProjectDetailDeploysDeployConnectionNodesDeploy) bool {
+		return d.Id == id
+	})
+	if !ok {
+		return nil
+	}
+	return &node.DeployConfigurationDetail
+}
+
+// helmInput returns the helm configuration from either helm_config or the legacy helm JSON.
+func (d *ProjectDeployModel) helmInput() (*zeetv1.DeploymentConfigurationKubernetesHelmInput, error) {
+	if d.HelmConfig != nil {
+		return d.HelmConfig.toInput(), nil
+	}
+	if d.Helm.IsNull() || d.Helm.IsUnknown() {
+		return nil, nil
+	}
+
+	input := &zeetv1.DeploymentConfigurationKubernetesHelmInput{}
+	if err := json.Unmarshal([]byte(d.Helm.ValueString()), input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// imported reports whether the deploy has no configuration in the prior state, that is when it's being imported.
+func (d *ProjectDeployModel) imported() bool {
+	return d.HelmConfig == nil && d.Helm.IsNull() && d.KubernetesConfig == nil && d.Kubernetes.IsNull() &&
+		d.TerraformConfig == nil && d.Terraform.IsNull()
+}
+
+// ProjectDeployKubernetesModel mirrors zeetv1.DeploymentConfigurationKubernetesInput for manifest deploys,
+// the generator of container apps is only available through the kubernetes JSON.
+type ProjectDeployKubernetesModel struct {
+	Source       *ProjectDeployKubernetesSourceModel `tfsdk:"source"`
+	UseKustomize types.Bool                          `tfsdk:"use_kustomize"`
+	ClusterId    customtypes.UUIDValue               `tfsdk:"cluster_id"`
+	Namespace    types.String                        `tfsdk:"namespace"`
+}
+
+type ProjectDeployKubernetesSourceModel struct {
+	Git *ProjectDeployGitSourceModel `tfsdk:"git"`
+}
+
+func projectDeployKubernetesSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Kubernetes manifests deployment configuration, GraphQL type [`DeploymentConfigurationKubernetesInput`](https://docs.zeet.co/graphql/inputs/deployment-configuration-kubernetes-input/), the manifests are read from the `source`, inline manifests are not supported by the API",
+		Optional:            true,
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("kubernetes")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"source": schema.SingleNestedAttribute{
+				MarkdownDescription: "Source of the manifests, defaults to the source of the blueprint",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"git": schema.SingleNestedAttribute{
+						MarkdownDescription: "Manifests in a git repository, `path` is the directory containing the manifests",
+						Required:            true,
+						Attributes:          projectDeployGitSourceAttributes(),
+					},
+				},
+			},
+			"use_kustomize": schema.BoolAttribute{
+				MarkdownDescription: "Builds the manifests with [Kustomize](https://kustomize.io/), `path` must contain a `kustomization.yaml`",
+				Optional:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Target cluster identifier",
+				Required:            true,
+				CustomType:          customtypes.UUIDType{},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Kubernetes namespace of the manifests, defaults to the namespace chosen by Zeet",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (m *ProjectDeployKubernetesModel) toInput() *zeetv1.DeploymentConfigurationKubernetesInput {
+	input := &zeetv1.DeploymentConfigurationKubernetesInput{
+		Target: &zeetv1.ManifestTargetConfigurationInput{
+			ClusterId: m.ClusterId.ValueUUID(),
+			Namespace: m.Namespace.ValueStringPointer(),
+		},
+	}
+	if m.Source != nil || !m.UseKustomize.IsNull() {
+		input.Blueprint = &zeetv1.BlueprintManifestConfigurationInput{
+			UseKustomize: m.UseKustomize.ValueBoolPointer(),
+		}
+		if m.Source != nil {
+			input.Blueprint.Source = &zeetv1.SourceInput{
+				Git: m.Source.Git.toInput(),
+			}
+		}
+	}
+	return input
+}
+
+// newKubernetesInput maps the kubernetes configuration of a deploy back to its input.
+func newKubernetesInput(kubernetes *zeetv1.DeployConfigurationDetailConfigurationDeploymentConfigurationKubernetes) (*zeetv1.DeploymentConfigurationKubernetesInput, error) {
+	input := &zeetv1.DeploymentConfigurationKubernetesInput{}
+	if target := kubernetes.Target; target != nil {
+		input.Target = &zeetv1.ManifestTargetConfigurationInput{
+			ClusterId: target.ClusterId,
+			Namespace: target.Namespace,
+		}
+	}
+	if blueprint := kubernetes.Blueprint; blueprint != nil {
+		input.Blueprint = &zeetv1.BlueprintManifestConfigurationInput{
+			UseKustomize: blueprint.UseKustomize,
+		}
+		if blueprint.Source != nil && blueprint.Source.Git != nil {
+			input.Blueprint.Source = &zeetv1.SourceInput{
+				Git: newGitSourceInput(blueprint.Source.Git),
+			}
+		}
+	}
+	// the generator is only managed through the kubernetes JSON, its detail matches the input
+	if kubernetes.Generator != nil {
+		generatorJson, err := json.Marshal(kubernetes.Generator)
+		if err != nil {
+			return nil, err
+		}
+		input.Generator = &zeetv1.KubernetesGeneratorConfigurationInput{}
+		if err := json.Unmarshal(generatorJson, input.Generator); err != nil {
+			return nil, err
+		}
+	}
+	return input, nil
+}
+
+// newProjectDeployKubernetesModel maps the deploy back to the typed kubernetes configuration.
+// Optional attributes are only read when the prior state manages them, see newProjectDeployHelmModel.
+func newProjectDeployKubernetesModel(kubernetes *zeetv1.DeploymentConfigurationKubernetesInput, prev *ProjectDeployKubernetesModel) *ProjectDeployKubernetesModel {
+	imported := prev == nil
+	if imported {
+		prev = &ProjectDeployKubernetesModel{}
+	}
+
+	model := &ProjectDeployKubernetesModel{}
+	if target := kubernetes.Target; target != nil {
+		model.ClusterId = customtypes.NewUUIDValue(target.ClusterId)
+		model.Namespace = optionalStringValue(target.Namespace, imported || !prev.Namespace.IsNull())
+	}
+	if blueprint := kubernetes.Blueprint; blueprint != nil {
+		model.UseKustomize = optionalBoolValue(blueprint.UseKustomize, imported || !prev.UseKustomize.IsNull())
+		if blueprint.Source != nil && blueprint.Source.Git != nil && (imported || prev.Source != nil) {
+			model.Source = &ProjectDeployKubernetesSourceModel{
+				Git: newProjectDeployGitSourceModel(blueprint.Source.Git, lo.FromPtr(prev.Source).Git, imported),
+			}
+		}
+	}
+	return model
+}
+
+// kubernetesInput returns the kubernetes configuration from either kubernetes_config or the legacy kubernetes JSON.
+func (d *ProjectDeployModel) kubernetesInput() (*zeetv1.DeploymentConfigurationKubernetesInput, error) {
+	if d.KubernetesConfig != nil {
+		return d.KubernetesConfig.toInput(), nil
+	}
+	if d.Kubernetes.IsNull() || d.Kubernetes.IsUnknown() {
+		return nil, nil
+	}
+
+	input := &zeetv1.DeploymentConfigurationKubernetesInput{}
+	if err := json.Unmarshal([]byte(d.Kubernetes.ValueString()), input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ProjectDeployTerraformModel mirrors zeetv1.DeploymentConfigurationTerraformInput.
+type ProjectDeployTerraformModel struct {
+	Provider            ProjectDeployTerraformProviderModel     `tfsdk:"provider"`
+	StateBackend        ProjectDeployTerraformStateBackendModel `tfsdk:"state_backend"`
+	ModuleName          types.String                            `tfsdk:"module_name"`
+	Source              *ProjectDeployTerraformSourceModel      `tfsdk:"source"`
+	TerraformVersion    types.String                            `tfsdk:"terraform_version"`
+	OutputConfiguration *ProjectDeployTerraformOutputModel      `tfsdk:"output_configuration"`
+}
+
+type ProjectDeployTerraformProviderModel struct {
+	AwsAccountId customtypes.UUIDValue `tfsdk:"aws_account_id"`
+	GcpAccountId customtypes.UUIDValue `tfsdk:"gcp_account_id"`
+	DoAccountId  customtypes.UUIDValue `tfsdk:"do_account_id"`
+	Region       types.String          `tfsdk:"region"`
+}
+
+type ProjectDeployTerraformStateBackendModel struct {
+	S3Bucket  *ProjectDeployTerraformS3BucketModel  `tfsdk:"s3_bucket"`
+	GcsBucket *ProjectDeployTerraformGcsBucketModel `tfsdk:"gcs_bucket"`
+}
+
+type ProjectDeployTerraformS3BucketModel struct {
+	AwsAccountId customtypes.UUIDValue `tfsdk:"aws_account_id"`
+	BucketName   types.String          `tfsdk:"bucket_name"`
+	Region       types.String          `tfsdk:"region"`
+	Key          types.String          `tfsdk:"key"`
+}
+
+type ProjectDeployTerraformGcsBucketModel struct {
+	GcpAccountId customtypes.UUIDValue `tfsdk:"gcp_account_id"`
+	BucketName   types.String          `tfsdk:"bucket_name"`
+	Location     types.String          `tfsdk:"location"`
+	Prefix       types.String          `tfsdk:"prefix"`
+}
+
+type ProjectDeployTerraformSourceModel struct {
+	Git    *ProjectDeployGitSourceModel       `tfsdk:"git"`
+	Module *ProjectDeployTerraformModuleModel `tfsdk:"module"`
+}
+
+type ProjectDeployTerraformModuleModel struct {
+	Source               types.String `tfsdk:"source"`
+	Version              types.String `tfsdk:"version"`
+	GithubInstallationId types.Int64  `tfsdk:"github_installation_id"`
+}
+
+type ProjectDeployTerraformOutputModel struct {
+	AutomaticDisabled  types.Bool           `tfsdk:"automatic_disabled"`
+	AutomaticSensitive types.Bool           `tfsdk:"automatic_sensitive"`
+	AutomaticExcluded  []types.String       `tfsdk:"automatic_excluded"`
+	Customization      jsontypes.Normalized `tfsdk:"customization"`
+}
+
+func projectDeployTerraformSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Terraform deployment configuration, GraphQL type [`DeploymentConfigurationTerraformInput`](https://docs.zeet.co/graphql/inputs/deployment-configuration-terraform-input/)",
+		Optional:            true,
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("terraform")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"provider": schema.SingleNestedAttribute{
+				MarkdownDescription: "Cloud account the module is applied to",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"aws_account_id": schema.StringAttribute{
+						MarkdownDescription: "AWS account identifier",
+						Optional:            true,
+						CustomType:          customtypes.UUIDType{},
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("aws_account_id"),
+								path.MatchRelative().AtParent().AtName("gcp_account_id"),
+								path.MatchRelative().AtParent().AtName("do_account_id"),
+							),
+						},
+					},
+					"gcp_account_id": schema.StringAttribute{
+						MarkdownDescription: "GCP account identifier",
+						Optional:            true,
+						CustomType:          customtypes.UUIDType{},
+					},
+					"do_account_id": schema.StringAttribute{
+						MarkdownDescription: "DigitalOcean account identifier",
+						Optional:            true,
+						CustomType:          customtypes.UUIDType{},
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region name, when applicable",
+						Optional:            true,
+					},
+				},
+			},
+			"state_backend": schema.SingleNestedAttribute{
+				MarkdownDescription: "Backend storing the Terraform state",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"s3_bucket": schema.SingleNestedAttribute{
+						MarkdownDescription: "S3 bucket backend",
+						Optional:            true,
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("gcs_bucket")),
+						},
+						Attributes: map[string]schema.Attribute{
+							"aws_account_id": schema.StringAttribute{
+								MarkdownDescription: "AWS account identifier",
+								Required:            true,
+								CustomType:          customtypes.UUIDType{},
+							},
+							"bucket_name": schema.StringAttribute{
+								MarkdownDescription: "Bucket name",
+								Required:            true,
+							},
+							"region": schema.StringAttribute{
+								MarkdownDescription: "Bucket region",
+								Required:            true,
+							},
+							"key": schema.StringAttribute{
+								MarkdownDescription: "Key of the state object, defaults to the key chosen by Zeet",
+								Optional:            true,
+							},
+						},
+					},
+					"gcs_bucket": schema.SingleNestedAttribute{
+						MarkdownDescription: "GCS bucket backend",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"gcp_account_id": schema.StringAttribute{
+								MarkdownDescription: "GCP account identifier",
+								Required:            true,
+								CustomType:          customtypes.UUIDType{},
+							},
+							"bucket_name": schema.StringAttribute{
+								MarkdownDescription: "Bucket name",
+								Required:            true,
+							},
+							"location": schema.StringAttribute{
+								MarkdownDescription: "Bucket location, defaults to the location chosen by Zeet",
+								Optional:            true,
+							},
+							"prefix": schema.StringAttribute{
+								MarkdownDescription: "Prefix of the state objects, defaults to the prefix chosen by Zeet",
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+			"module_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the module in the generated configuration, defaults to the name chosen by Zeet",
+				Optional:            true,
+			},
+			"source": schema.SingleNestedAttribute{
+				MarkdownDescription: "Source of the Terraform module, defaults to the source of the blueprint",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"git": schema.SingleNestedAttribute{
+						MarkdownDescription: "Module in a git repository",
+						Optional:            true,
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("module")),
+						},
+						Attributes: projectDeployGitSourceAttributes(),
+					},
+					"module": schema.SingleNestedAttribute{
+						MarkdownDescription: "Module from a Terraform registry or any other [module source](https://developer.hashicorp.com/terraform/language/modules/sources)",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"source": schema.StringAttribute{
+								MarkdownDescription: "Module source",
+								Required:            true,
+							},
+							"version": schema.StringAttribute{
+								MarkdownDescription: "Module version, only applicable to registry sources",
+								Optional:            true,
+							},
+							"github_installation_id": schema.Int64Attribute{
+								MarkdownDescription: "GitHub app installation identifier for private sources",
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+			"terraform_version": schema.StringAttribute{
+				MarkdownDescription: "Terraform version, defaults to the version of the blueprint",
+				Optional:            true,
+			},
+			"output_configuration": schema.SingleNestedAttribute{
+				MarkdownDescription: "Outputs of the deployment",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"automatic_disabled": schema.BoolAttribute{
+						MarkdownDescription: "Disables the automatic `outputs` map containing all module outputs",
+						Optional:            true,
+					},
+					"automatic_sensitive": schema.BoolAttribute{
+						MarkdownDescription: "Marks the automatic `outputs` map as sensitive, required when a sensitive module output is not excluded",
+						Optional:            true,
+					},
+					"automatic_excluded": schema.ListAttribute{
+						MarkdownDescription: "Module outputs excluded from the automatic `outputs` map",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"customization": schema.StringAttribute{
+						MarkdownDescription: "Custom `output` block in [Terraform JSON syntax](https://developer.hashicorp.com/terraform/language/syntax/json)",
+						Optional:            true,
+						CustomType:          jsontypes.NormalizedType{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (m *ProjectDeployTerraformModel) toInput() *zeetv1.DeploymentConfigurationTerraformInput {
+	input := &zeetv1.DeploymentConfigurationTerraformInput{
+		Target: &zeetv1.TerraformTargetConfigurationInput{
+			ModuleName: m.ModuleName.ValueStringPointer(),
+			Provider: zeetv1.TerraformProviderInput{
+				AwsAccountId: m.Provider.AwsAccountId.ValueUUIDPointer(),
+				GcpAccountId: m.Provider.GcpAccountId.ValueUUIDPointer(),
+				DoAccountId:  m.Provider.DoAccountId.ValueUUIDPointer(),
+				Region:       m.Provider.Region.ValueStringPointer(),
+			},
+		},
+	}
+	if s3 := m.StateBackend.S3Bucket; s3 != nil {
+		input.Target.StateBackend.S3Bucket = &zeetv1.S3BucketBackendInput{
+			AwsAccountId: s3.AwsAccountId.ValueUUID(),
+			BucketName:   s3.BucketName.ValueString(),
+			Region:       s3.Region.ValueString(),
+			Key:          s3.Key.ValueStringPointer(),
+		}
+	}
+	if gcs := m.StateBackend.GcsBucket; gcs != nil {
+		input.Target.StateBackend.GcsBucket = &zeetv1.GCSBucketBackendInput{
+			GcpAccountId: gcs.GcpAccountId.ValueUUID(),
+			BucketName:   gcs.BucketName.ValueString(),
+			Location:     gcs.Location.ValueStringPointer(),
+			Prefix:       gcs.Prefix.ValueStringPointer(),
+		}
+	}
+
+	if m.Source == nil && m.TerraformVersion.IsNull() && m.OutputConfiguration == nil {
+		return input
+	}
+	input.Blueprint = &zeetv1.BlueprintTerraformConfigurationInput{
+		TerraformVersion: m.TerraformVersion.ValueStringPointer(),
+	}
+	if m.Source != nil {
+		input.Blueprint.Source = &zeetv1.SourceInput{}
+		if m.Source.Git != nil {
+			input.Blueprint.Source.Git = m.Source.Git.toInput()
+		}
+		if module := m.Source.Module; module != nil {
+			input.Blueprint.Source.TerraformModule = &zeetv1.TerraformModuleSourceInput{
+				Source:  module.Source.ValueString(),
+				Version: module.Version.ValueStringPointer(),
+			}
+			if !module.GithubInstallationId.IsNull() {
+				input.Blueprint.Source.TerraformModule.Integration = &zeetv1.TerraformModuleSourceIntegrationInput{
+					Git: &zeetv1.GitSourceIntegrationInput{
+						GithubInstallationId: lo.ToPtr(int(module.GithubInstallationId.ValueInt64())),
+					},
+				}
+			}
+		}
+	}
+	if output := m.OutputConfiguration; output != nil {
+		input.Blueprint.OutputConfiguration = &zeetv1.TerraformOutputConfigurationInput{
+			Customization: output.Customization.ValueStringPointer(),
+		}
+		if !output.AutomaticDisabled.IsNull() || !output.AutomaticSensitive.IsNull() || output.AutomaticExcluded != nil {
+			input.Blueprint.OutputConfiguration.Automatic = &zeetv1.TerraformAutomaticOutputConfigurationInput{
+				Disabled:  output.AutomaticDisabled.ValueBoolPointer(),
+				Sensitive: output.AutomaticSensitive.ValueBoolPointer(),
+				Excluded: lo.Map(output.AutomaticExcluded, func(s types.String, _ int) string {
+					return s.ValueString()
+				}),
+			}
+		}
+	}
+	return input
+}
+
+// newTerraformInput maps the terraform configuration of a deploy back to its input, the API returns the accounts
+// as objects and empty objects for the optional configurations that are not set.
+func newTerraformInput(terraform *zeetv1.DeployConfigurationDetailConfigurationDeploymentConfigurationTerraform) *zeetv1.DeploymentConfigurationTerraformInput {
+	input := &zeetv1.DeploymentConfigurationTerraformInput{}
+	if target := terraform.Target; target != nil {
+		input.Target = &zeetv1.TerraformTargetConfigurationInput{
+			ModuleName: target.ModuleName,
+		}
+		if provider := target.Provider; provider != nil {
+			input.Target.Provider.Region = provider.Region
+			if provider.AwsAccount != nil {
+				input.Target.Provider.AwsAccountId = lo.ToPtr(provider.AwsAccount.Id)
+			}
+			if provider.GcpAccount != nil {
+				input.Target.Provider.GcpAccountId = lo.ToPtr(provider.GcpAccount.Id)
+			}
+			if provider.DoAccount != nil {
+				input.Target.Provider.DoAccountId = lo.ToPtr(provider.DoAccount.Id)
+			}
+		}
+		if backend := target.StateBackend; backend != nil {
+			if backend.S3Bucket != nil {
+				input.Target.StateBackend.S3Bucket = &zeetv1.S3BucketBackendInput{
+					AwsAccountId: backend.S3Bucket.AwsAccountId,
+					BucketName:   backend.S3Bucket.BucketName,
+					Region:       backend.S3Bucket.Region,
+					Key:          backend.S3Bucket.Key,
+				}
+			}
+			if backend.GcsBucket != nil {
+				input.Target.StateBackend.GcsBucket = &zeetv1.GCSBucketBackendInput{
+					GcpAccountId: backend.GcsBucket.GcpAccountId,
+					BucketName:   backend.GcsBucket.BucketName,
+					Location:     lo.EmptyableToPtr(backend.GcsBucket.Location),
+					Prefix:       backend.GcsBucket.Prefix,
+				}
+			}
+		}
+	}
+
+	blueprint := terraform.Blueprint
+	if blueprint == nil {
+		return input
+	}
+	input.Blueprint = &zeetv1.BlueprintTerraformConfigurationInput{
+		TerraformVersion: blueprint.TerraformVersion,
+	}
+	if source := blueprint.Source; source != nil && (source.Git != nil || source.TerraformModule != nil) {
+		input.Blueprint.Source = &zeetv1.SourceInput{}
+		if source.Git != nil {
+			input.Blueprint.Source.Git = newGitSourceInput(source.Git)
+		}
+		if module := source.TerraformModule; module != nil {
+			input.Blueprint.Source.TerraformModule = &zeetv1.TerraformModuleSourceInput{
+				Source:  module.Source,
+				Version: module.Version,
+			}
+			if module.Integration != nil && module.Integration.Git != nil && module.Integration.Git.GithubInstallationId != nil {
+				input.Blueprint.Source.TerraformModule.Integration = &zeetv1.TerraformModuleSourceIntegrationInput{
+					Git: &zeetv1.GitSourceIntegrationInput{
+						GithubInstallationId: module.Integration.Git.GithubInstallationId,
+					},
+				}
+			}
+		}
+	}
+	if output := blueprint.OutputConfiguration; output != nil {
+		automatic := output.Automatic
+		if automatic != nil && automatic.Disabled == nil && automatic.Sensitive == nil && len(automatic.Excluded) == 0 {
+			automatic = nil
+		}
+		if automatic != nil || output.Customization != nil {
+			input.Blueprint.OutputConfiguration = &zeetv1.TerraformOutputConfigurationInput{
+				Customization: output.Customization,
+			}
+		}
+		if automatic != nil {
+			input.Blueprint.OutputConfiguration.Automatic = &zeetv1.TerraformAutomaticOutputConfigurationInput{
+				Disabled:  automatic.Disabled,
+				Sensitive: automatic.Sensitive,
+				Excluded:  automatic.Excluded,
+			}
+		}
+	}
+	return input
+}
+
+// newProjectDeployTerraformModel maps the deploy back to the typed terraform configuration.
+// Optional attributes are only read when the prior state manages them, see newProjectDeployHelmModel.
+func newProjectDeployTerraformModel(terraform *zeetv1.DeploymentConfigurationTerraformInput, prev *ProjectDeployTerraformModel) *ProjectDeployTerraformModel {
+	imported := prev == nil
+	if imported {
+		prev = &ProjectDeployTerraformModel{}
+	}
+
+	model := &ProjectDeployTerraformModel{}
+	if target := terraform.Target; target != nil {
+		model.ModuleName = optionalStringValue(target.ModuleName, imported || !prev.ModuleName.IsNull())
+		model.Provider = ProjectDeployTerraformProviderModel{
+			AwsAccountId: optionalUUIDValue(target.Provider.AwsAccountId),
+			GcpAccountId: optionalUUIDValue(target.Provider.GcpAccountId),
+			DoAccountId:  optionalUUIDValue(target.Provider.DoAccountId),
+			Region:       optionalStringValue(target.Provider.Region, imported || !prev.Provider.Region.IsNull()),
+		}
+		if s3 := target.StateBackend.S3Bucket; s3 != nil {
+			prevS3 := lo.FromPtr(prev.StateBackend.S3Bucket)
+			model.StateBackend.S3Bucket = &ProjectDeployTerraformS3BucketModel{
+				AwsAccountId: customtypes.NewUUIDValue(s3.AwsAccountId),
+				BucketName:   types.StringValue(s3.BucketName),
+				Region:       types.StringValue(s3.Region),
+				Key:          optionalStringValue(s3.Key, imported || !prevS3.Key.IsNull()),
+			}
+		}
+		if gcs := target.StateBackend.GcsBucket; gcs != nil {
+			prevGcs := lo.FromPtr(prev.StateBackend.GcsBucket)
+			model.StateBackend.GcsBucket = &ProjectDeployTerraformGcsBucketModel{
+				GcpAccountId: customtypes.NewUUIDValue(gcs.GcpAccountId),
+				BucketName:   types.StringValue(gcs.BucketName),
+				Location:     optionalStringValue(gcs.Location, imported || !prevGcs.Location.IsNull()),
+				Prefix:       optionalStringValue(gcs.Prefix, imported || !prevGcs.Prefix.IsNull()),
+			}
+		}
+	}
+
+	blueprint := terraform.Blueprint
+	if blueprint == nil {
+		return model
+	}
+	model.TerraformVersion = optionalStringValue(blueprint.TerraformVersion, imported || !prev.TerraformVersion.IsNull())
+
+	if source := blueprint.Source; source != nil && (imported || prev.Source != nil) {
+		prevSource := lo.FromPtr(prev.Source)
+		model.Source = &ProjectDeployTerraformSourceModel{}
+		if source.Git != nil {
+			model.Source.Git = newProjectDeployGitSourceModel(source.Git, prevSource.Git, imported)
+		}
+		if module := source.TerraformModule; module != nil {
+			prevModule := lo.FromPtr(prevSource.Module)
+			model.Source.Module = &ProjectDeployTerraformModuleModel{
+				Source:  types.StringValue(module.Source),
+				Version: optionalStringValue(module.Version, imported || !prevModule.Version.IsNull()),
+			}
+			if module.Integration != nil && module.Integration.Git != nil && module.Integration.Git.GithubInstallationId != nil {
+				model.Source.Module.GithubInstallationId = types.Int64Value(int64(*module.Integration.Git.GithubInstallationId))
+			}
+		}
+	}
+
+	if output := blueprint.OutputConfiguration; output != nil && (imported || prev.OutputConfiguration != nil) {
+		prevOutput := lo.FromPtr(prev.OutputConfiguration)
+		model.OutputConfiguration = &ProjectDeployTerraformOutputModel{}
+		if output.Customization != nil {
+			model.OutputConfiguration.Customization = jsontypes.NewNormalizedValue(*output.Customization)
+		}
+		if automatic := output.Automatic; automatic != nil {
+			model.OutputConfiguration.AutomaticDisabled = optionalBoolValue(automatic.Disabled, imported || !prevOutput.AutomaticDisabled.IsNull())
+			model.OutputConfiguration.AutomaticSensitive = optionalBoolValue(automatic.Sensitive, imported || !prevOutput.AutomaticSensitive.IsNull())
+			if len(automatic.Excluded) > 0 || prevOutput.AutomaticExcluded != nil {
+				model.OutputConfiguration.AutomaticExcluded = lo.Map(automatic.Excluded, func(s string, _ int) types.String {
+					return types.StringValue(s)
+				})
+			}
+		}
+	}
+
+	return model
+}
+
+// terraformInput returns the terraform configuration from either terraform_config or the legacy terraform JSON.
+func (d *ProjectDeployModel) terraformInput() (*zeetv1.DeploymentConfigurationTerraformInput, error) {
+	if d.TerraformConfig != nil {
+		return d.TerraformConfig.toInput(), nil
+	}
+	if d.Terraform.IsNull() || d.Terraform.IsUnknown() {
+		return nil, nil
+	}
+
+	input := &zeetv1.DeploymentConfigurationTerraformInput{}
+	if err := json.Unmarshal([]byte(d.Terraform.ValueString()), input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+func optionalStringValue(value *string, managed bool) types.String {
+	if value == nil || !managed {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+func optionalBoolValue(value *bool, managed bool) types.Bool {
+	if value == nil || !managed {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*value)
+}
+
+func optionalUUIDValue(value *uuid.UUID) customtypes.UUIDValue {
+	if value == nil {
+		return customtypes.NewUUIDNull()
+	}
+	return customtypes.NewUUIDValue(*value)
+}
+
+// ProjectDeployGitSourceModel mirrors zeetv1.GitSourceInput, it's shared by the deploy drivers sourcing from git.
+type ProjectDeployGitSourceModel struct {
+	Repository           types.String          `tfsdk:"repository"`
+	Ref                  types.String          `tfsdk:"ref"`
+	Path                 types.String          `tfsdk:"path"`
+	GithubInstallationId types.Int64           `tfsdk:"github_installation_id"`
+	GithubIntegrationId  customtypes.UUIDValue `tfsdk:"github_integration_id"`
+	GitlabIntegrationId  customtypes.UUIDValue `tfsdk:"gitlab_integration_id"`
+}
+
+func projectDeployGitSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"repository": schema.StringAttribute{
+			MarkdownDescription: "Repository URL",
+			Required:            true,
+		},
+		"ref": schema.StringAttribute{
+			MarkdownDescription: "Git reference, defaults to the default branch",
+			Optional:            true,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "Path in the repository",
+			Optional:            true,
+		},
+		"github_installation_id": schema.Int64Attribute{
+			MarkdownDescription: "GitHub app installation identifier for private repositories",
+			Optional:            true,
+		},
+		"github_integration_id": schema.StringAttribute{
+			MarkdownDescription: "GitHub integration identifier for private repositories",
+			Optional:            true,
+			CustomType:          customtypes.UUIDType{},
+		},
+		"gitlab_integration_id": schema.StringAttribute{
+			MarkdownDescription: "GitLab integration identifier for private repositories",
+			Optional:            true,
+			CustomType:          customtypes.UUIDType{},
+		},
+	}
+}
+
+func (m *ProjectDeployGitSourceModel) toInput() *zeetv1.GitSourceInput {
+	input := &zeetv1.GitSourceInput{
+		Repository: m.Repository.ValueString(),
+		Ref:        m.Ref.ValueStringPointer(),
+		Path:       m.Path.ValueStringPointer(),
+	}
+	if !m.GithubInstallationId.IsNull() || !m.GithubIntegrationId.IsNull() || !m.GitlabIntegrationId.IsNull() {
+		input.Integration = &zeetv1.GitSourceIntegrationInput{
+			GithubIntegrationId: m.GithubIntegrationId.ValueUUIDPointer(),
+			GitlabIntegrationId: m.GitlabIntegrationId.ValueUUIDPointer(),
+		}
+		if !m.GithubInstallationId.IsNull() {
+			input.Integration.GithubInstallationId = lo.ToPtr(int(m.GithubInstallationId.ValueInt64()))
+		}
+	}
+	return input
+}
+
+func newGitSourceInput(git *zeetv1.ProjectSourceDetailGitGitSource) *zeetv1.GitSourceInput {
+	input := &zeetv1.GitSourceInput{
+		Repository: git.Repository,
+		Ref:        git.Ref,
+		Path:       git.Path,
+	}
+	if git.Integration != nil && !lo.IsEmpty(*git.Integration) {
+		input.Integration = &zeetv1.GitSourceIntegrationInput{
+			GithubInstallationId: git.Integration.GithubInstallationId,
+			GithubIntegrationId:  git.Integration.GithubIntegrationId,
+			GitlabIntegrationId:  git.Integration.GitlabIntegrationId,
+		}
+	}
+	return input
+}
+
+func newProjectDeployGitSourceModel(git *zeetv1.GitSourceInput, prev *ProjectDeployGitSourceModel, imported bool) *ProjectDeployGitSourceModel {
+	prevGit := lo.FromPtr(prev)
+	integration := lo.FromPtr(git.Integration)
+	model := &ProjectDeployGitSourceModel{
+		Repository:          types.StringValue(git.Repository),
+		Ref:                 optionalStringValue(git.Ref, imported || !prevGit.Ref.IsNull()),
+		Path:                optionalStringValue(git.Path, imported || !prevGit.Path.IsNull()),
+		GithubIntegrationId: optionalUUIDValue(integration.GithubIntegrationId),
+		GitlabIntegrationId: optionalUUIDValue(integration.GitlabIntegrationId),
+	}
+	if integration.GithubInstallationId != nil {
+		model.GithubInstallationId = types.Int64Value(int64(*integration.GithubInstallationId))
+	}
+	return model
+}
@@ -6,11 +6,18 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
 
 	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
@@ -23,6 +30,17 @@ destroy = Delete
 */
 func TestAccProjectResourceHelm(t *testing.T) {
 	readCalls := 0
+	// every create or update starts a workflow run
+	var runs []zeetv1.WorkflowRunsTeamProjectWorkflowRunsWorkflowRunConnectionNodesWorkflowRun
+	run := func() {
+		runs = append(runs, zeetv1.WorkflowRunsTeamProjectWorkflowRunsWorkflowRunConnectionNodesWorkflowRun{
+			WorkflowRunListItem: zeetv1.WorkflowRunListItem{
+				Id:       uuid.New(),
+				Sequence: len(runs) + 1,
+				Status:   zeetv1.WorkflowRunStatusCompleted,
+			},
+		})
+	}
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		req, err := io.ReadAll(r.Body)
 		if err != nil {
@@ -41,7 +59,17 @@ func TestAccProjectResourceHelm(t *testing.T) {
 					},
 				},
 			})
+		} else if strings.Contains(reqs, "mutation createDeploy") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"createDeploy": map[string]any{"id": testDeployId, "name": "main"}},
+			})
+		} else if strings.Contains(reqs, "mutation submitWorkflowRun") {
+			run()
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"submitWorkflow": map[string]any{"id": runs[len(runs)-1].Id}},
+			})
 		} else if strings.Contains(reqs, "mutation updateProject") && strings.Contains(reqs, "two") {
+			run()
 			json.NewEncoder(w).Encode(map[string]any{
 				"data": zeetv1.UpdateProjectResponse{
 					UpdateProject: zeetv1.UpdateProjectUpdateProject{
@@ -94,6 +122,51 @@ func TestAccProjectResourceHelm(t *testing.T) {
 					"data": data,
 				})
 			}
+		} else if strings.Contains(reqs, "query projectWorkflowSteps") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"team": map[string]any{
+						"project": map[string]any{
+							"workflow": map[string]any{
+								"id":    testWorkflowId,
+								"steps": []map[string]any{{"action": "ORCHESTRATION_DEPLOY", "disabled": false}},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query workflowRuns") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.WorkflowRunsResponse{
+					Team: &zeetv1.WorkflowRunsTeam{
+						Project: &zeetv1.WorkflowRunsTeamProject{
+							Workflow: &zeetv1.WorkflowRunsTeamProjectWorkflow{
+								Runs: zeetv1.WorkflowRunsTeamProjectWorkflowRunsWorkflowRunConnection{
+									Nodes: runs,
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query workflowRunDetail") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.WorkflowRunDetailResponse{
+					Team: &zeetv1.WorkflowRunDetailTeam{
+						Project: &zeetv1.WorkflowRunDetailTeamProject{
+							Workflow: &zeetv1.WorkflowRunDetailTeamProjectWorkflow{
+								Run: zeetv1.WorkflowRunDetailTeamProjectWorkflowRun{
+									WorkflowRunDetail: zeetv1.WorkflowRunDetail{
+										WorkflowRunListItem: runs[len(runs)-1].WorkflowRunListItem,
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query blueprint (") {
+			json.NewEncoder(w).Encode(testAccBlueprintVariablesResponse())
 		} else if strings.Contains(reqs, "mutation deleteProject") {
 			json.NewEncoder(w).Encode(map[string]any{
 				"data": zeetv1.DeleteProjectResponse{
@@ -116,6 +189,7 @@ func TestAccProjectResourceHelm(t *testing.T) {
 					resource.TestCheckResourceAttr("zeet_project.test_helm", "name", "one"),
 					resource.TestCheckResourceAttr("zeet_project.test_helm", "team_id", testTeamId.String()),
 					resource.TestCheckResourceAttr("zeet_project.test_helm", "id", testProjectId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "wait_for_workflow_run", "true"),
 				),
 			},
 			// Update and Read testing
@@ -144,7 +218,8 @@ resource "zeet_project" "test_helm" {
   name = %[2]q
   blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
 
-  deploys = [{
+  deploys = {
+    main = {
 	default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
 	helm = jsonencode({
 	  blueprint = {
@@ -161,148 +236,21 @@ resource "zeet_project" "test_helm" {
 		releaseName: "grafana"
 	  }
 	})
-  }]
+    }
+  }
 
   workflow = {
     steps: jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
   }
 
   enabled = true
+  wait_for_workflow_run = true
 }
 `, server, name, clusterID)
 }
 
-func TestAccProjectResourceContainer(t *testing.T) {
-	var created bool
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		req, err := io.ReadAll(r.Body)
-		if err != nil {
-			t.Fatal(err)
-		}
-		reqs := string(req)
-		if strings.Contains(reqs, "mutation createResourceAlpha") && strings.Contains(reqs, "one") {
-			// create step 1
-			json.NewEncoder(w).Encode(map[string]any{
-				"data": zeetv0.CreateResourceAlphaResponse{
-					CreateResourceAlpha: zeetv0.CreateResourceAlphaCreateResourceAlphaRepo{
-						Name: "one",
-						Project: &zeetv0.CreateResourceAlphaCreateResourceAlphaRepoProject{
-							Name: "p",
-						},
-						ProjectEnvironment: &zeetv0.CreateResourceAlphaCreateResourceAlphaRepoProjectEnvironment{
-							Name: "e",
-						},
-					},
-				},
-			})
-		} else if strings.Contains(reqs, "query projectV3") {
-			// create step 2
-			json.NewEncoder(w).Encode(map[string]any{
-				"data": zeetv0.ProjectV3Response{
-					User: zeetv0.ProjectV3User{
-						ProjectV3Adapters: &zeetv0.ProjectV3UserProjectV3AdaptersProjectV3AdapterConnection{
-							Nodes: []zeetv0.ProjectV3UserProjectV3AdaptersProjectV3AdapterConnectionNodesProjectV3Adapter{
-								{
-									ProjectV3AdapterDetail: zeetv0.ProjectV3AdapterDetail{
-										Id: testProjectId,
-										Repo: &zeetv0.ProjectV3AdapterDetailRepo{
-											Id: testRepoId.String(),
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			})
-		} else if strings.Contains(reqs, "mutation updateProjectSettings") && strings.Contains(reqs, "two") {
-			// update step 2
-			json.NewEncoder(w).Encode(map[string]any{
-				"data": zeetv0.UpdateProjectSettingsResponse{
-					UpdateProject: zeetv0.UpdateProjectSettingsUpdateProjectRepo{
-						Id: testRepoId.String(),
-						RepoDetail: zeetv0.RepoDetail{
-							RepoCommon: zeetv0.RepoCommon{
-								Name: "two",
-							},
-						},
-					},
-				},
-			})
-		} else if strings.Contains(reqs, "query userRepo") {
-			data := zeetv0.UserRepoResponse{
-				CurrentUser: zeetv0.UserRepoCurrentUser{
-					Repo: &zeetv0.UserRepoCurrentUserRepo{
-						Id: testRepoId.String(),
-						RepoDetail: zeetv0.RepoDetail{
-							DeployService: lo.ToPtr(true),
-							RepoCommon: zeetv0.RepoCommon{
-								Id:   testRepoId.String(),
-								Name: "one",
-								Source: zeetv0.RepoCommonSourceRepoSource{
-									Id:   "https://github.com/zeet-demo/node-express-demo.git",
-									Type: zeetv0.RepoSourceTypeGit,
-								},
-							},
-							RepoBuild: zeetv0.RepoBuild{
-								BuildMethod: &zeetv0.RepoBuildBuildMethod{
-									Type:             zeetv0.BuildTypeNode,
-									BuildCommand:     lo.ToPtr("npm --production=false install"),
-									RunCommand:       lo.ToPtr("npm start"),
-									WorkingDirectory: lo.ToPtr("./"),
-									NodejsVersion:    lo.ToPtr("18"),
-								},
-							},
-							DeployTarget: lo.ToPtr(zeetv0.DeployTargetKubernetes),
-							Cluster: &zeetv0.RepoDetailCluster{
-								Id: testClusterId,
-							},
-							Project: &zeetv0.RepoDetailProject{
-								Id: testGroupId,
-							},
-							ProjectEnvironment: &zeetv0.RepoDetailProjectEnvironment{
-								Id: testSubGroupId,
-							},
-							RepoNetwork: zeetv0.RepoNetwork{
-								Ports: []zeetv0.RepoNetworkPortsPort{
-									{
-										Port:     "3000",
-										Protocol: string(zeetv0.PortProtocolTcp),
-										Public:   true,
-										Https:    true,
-									},
-								},
-							},
-							Cpu:       lo.ToPtr("1"),
-							Memory:    lo.ToPtr("1G"),
-							Dedicated: lo.ToPtr(false),
-						},
-					},
-				},
-			}
-			if !created {
-				// create step 2
-				json.NewEncoder(w).Encode(map[string]any{
-					"data": data,
-				})
-				created = true
-			} else {
-				// update step 1
-				data.CurrentUser.Repo.RepoDetail.RepoCommon.Name = "two"
-				json.NewEncoder(w).Encode(map[string]any{
-					"data": data,
-				})
-			}
-		} else if strings.Contains(reqs, "mutation deleteProject") {
-			json.NewEncoder(w).Encode(map[string]any{
-				"data": zeetv0.DeleteProjectResponse{
-					DeleteRepo: true,
-				},
-			})
-		} else {
-			t.Fatal("unexpected request", reqs[:42])
-		}
-	}))
+func TestAccProjectResourceHelmConfig(t *testing.T) {
+	server := testAccProjectWorkflowServer(t)
 	defer server.Close()
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -310,79 +258,1492 @@ func TestAccProjectResourceContainer(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccProjectResourceConfigWithContainerDeployment(server.URL, "one", testClusterId.String()),
+				Config: testAccProjectResourceConfigWithHelmConfig(server.URL, "7.0.0", "replicas: 1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("zeet_project.test_container", "name", "one"),
-					resource.TestCheckResourceAttr("zeet_project.test_container", "team_id", testTeamId.String()),
-					resource.TestCheckResourceAttr("zeet_project.test_container", "container.repo_id", testRepoId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.id", testDeployId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.cluster_id", testClusterId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.chart.name", "grafana"),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.chart.version", "7.0.0"),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.namespace", "default"),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.release_name", "one"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithHelmConfig(server.URL, "7.1.0", "replicas: 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.chart.version", "7.1.0"),
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.values", "replicas: 2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_project.test_helm",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/" + testProjectId.String(),
+				ImportStateVerify: true,
+			},
+			// ImportState of a missing project testing
+			{
+				ResourceName:  "zeet_project.test_helm",
+				ImportState:   true,
+				ImportStateId: testTeamId.String() + "/" + uuid.NewString(),
+				ExpectError:   regexp.MustCompile("Cannot import non-existent remote object"),
+			},
+			// Reformatted values are kept as configured
+			{
+				Config: testAccProjectResourceConfigWithHelmConfig(server.URL, "7.1.0", "{ replicas: 2 }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_helm", "deploys.main.helm_config.values", "{ replicas: 2 }"),
 				),
 			},
-			// TODO: Update and Read testing
-			// {
-			// 	Config: testAccProjectResourceConfigWithContainerDeployment(server.URL, "two", "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"),
-			// 	Check: resource.ComposeAggregateTestCheckFunc(
-			// 		resource.TestCheckResourceAttr("zeet_project.test", "name", "two"),
-			// 	),
-			// },
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
-func testAccProjectResourceConfigWithContainerDeployment(server string, name string, clusterID string) string {
+func testAccProjectResourceConfigWithHelmConfig(server string, version string, values string) string {
 	return fmt.Sprintf(`
 provider "zeet" {
   api_url = %[1]q
 }
 
-resource "zeet_project" "test_container" {
+resource "zeet_project" "test_helm" {
   team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
   group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
   subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
 
-  name = %[2]q
+  name = "one"
   blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
 
-  container = {
-    source = {
-      git = jsonencode({
-        repository: "https://github.com/zeet-demo/node-express-demo.git"
-      })
-    }
-    build = jsonencode({
-      build = {
-        buildType: "NODE",
-        buildCommand: "npm --production=false install",
-        nodejsVersion: "18",
-        runCommand: "npm start",
-        workingDirectory: "./"
-      }
-    })
-    kubernetes = jsonencode({
-      deployTarget = {
-        deployTarget: "KUBERNETES",
-        clusterID: %[3]q
-      },
-      app = {
-        deployService: true,
-        ports: [
-          {
-            port: "3000",
-            protocol: "tcp",
-            public: true,
-            https: true
-          }
-        ],
-        resources = {
-          cpu: 1,
-          memory: 1,
-          spot: true
+  deploys = {
+    main = {
+      default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
+      helm_config = {
+        chart = {
+          repository_url = "https://grafana.github.io/helm-charts"
+          name           = "grafana"
+          version        = %[3]q
         }
+        cluster_id = %[2]q
+        values     = %[4]q
       }
-    })
+    }
+  }
+
+  workflow = {
+    steps: jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
   }
 
   enabled = true
 }
-`, server, name, clusterID)
+`, server, testClusterId.String(), version, values)
+}
+
+func TestAccProjectResourceDeploys(t *testing.T) {
+	server := testAccProjectWorkflowServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigWithDeploys(server.URL, "secondary", "replicas: 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.%", "2"),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.primary.id", testDeployId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.primary.helm_config.values", "replicas: 1"),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.secondary.helm_config.values", "replicas: 2"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithDeploys(server.URL, "secondary", "replicas: 3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.primary.id", testDeployId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.primary.helm_config.values", "replicas: 1"),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.secondary.helm_config.values", "replicas: 3"),
+				),
+			},
+			// Renaming a deploy deletes it and creates a new one in place
+			{
+				Config: testAccProjectResourceConfigWithDeploys(server.URL, "tertiary", "replicas: 4"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeet_project.test_deploys", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.%", "2"),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.primary.id", testDeployId.String()),
+					resource.TestCheckNoResourceAttr("zeet_project.test_deploys", "deploys.secondary.id"),
+					resource.TestCheckResourceAttrSet("zeet_project.test_deploys", "deploys.tertiary.id"),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.tertiary.helm_config.values", "replicas: 4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectResourceDeploysCreateFailure(t *testing.T) {
+	server := testAccProjectWorkflowServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A failed deploy leaves the created project in state, tainted
+			{
+				Config:      testAccProjectResourceConfigWithDeploys(server.URL, "secondary", "invalid: true"),
+				ExpectError: regexp.MustCompile("Unable to create deploy"),
+			},
+			// The tainted project is replaced
+			{
+				Config: testAccProjectResourceConfigWithDeploys(server.URL, "secondary", "replicas: 2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeet_project.test_deploys", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.%", "2"),
+					resource.TestCheckResourceAttr("zeet_project.test_deploys", "deploys.secondary.helm_config.values", "replicas: 2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithDeploys(server string, name string, values string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test_deploys" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = "one"
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  deploys = {
+    %[4]s = {
+      default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
+      helm_config = {
+        cluster_id = %[2]q
+        values     = %[3]q
+      }
+    }
+    primary = {
+      default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
+      helm_config = {
+        cluster_id = %[2]q
+        values     = "replicas: 1"
+      }
+    }
+  }
+
+  workflow = {
+    steps: jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
+  }
+
+  enabled = true
+}
+`, server, testClusterId.String(), values, name)
+}
+
+func TestAccProjectResourceKubernetesConfig(t *testing.T) {
+	server := testAccProjectWorkflowServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigWithKubernetesConfig(server.URL, "main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_kubernetes", "deploys.main.id", testDeployId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_kubernetes", "deploys.main.kubernetes_config.cluster_id", testClusterId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_kubernetes", "deploys.main.kubernetes_config.source.git.ref", "main"),
+					resource.TestCheckResourceAttr("zeet_project.test_kubernetes", "deploys.main.kubernetes_config.use_kustomize", "true"),
+					resource.TestCheckNoResourceAttr("zeet_project.test_kubernetes", "deploys.main.kubernetes_config.namespace"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithKubernetesConfig(server.URL, "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_kubernetes", "deploys.main.kubernetes_config.source.git.ref", "production"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_project.test_kubernetes",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/" + testProjectId.String(),
+				ImportStateVerify: true,
+				// server side defaults are read on import
+				ImportStateVerifyIgnore: []string{"deploys.main.kubernetes_config.namespace"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithKubernetesConfig(server string, ref string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test_kubernetes" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = "one"
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  deploys = {
+    main = {
+      default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
+      kubernetes_config = {
+        source = {
+          git = {
+            repository = "https://github.com/zeet-dev/manifests"
+            ref        = %[3]q
+            path       = "overlays/production"
+          }
+        }
+        use_kustomize = true
+        cluster_id    = %[2]q
+      }
+    }
+  }
+
+  workflow = {
+    steps: jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
+  }
+
+  enabled = true
+}
+`, server, testClusterId.String(), ref)
+}
+
+func TestAccProjectResourceTerraformConfig(t *testing.T) {
+	server := testAccProjectWorkflowServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigWithTerraformConfig(server.URL, "4.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_terraform", "deploys.main.id", testDeployId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_terraform", "deploys.main.terraform_config.provider.do_account_id", testCloudId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_terraform", "deploys.main.terraform_config.state_backend.s3_bucket.bucket_name", "terraform-state"),
+					resource.TestCheckResourceAttr("zeet_project.test_terraform", "deploys.main.terraform_config.source.module.version", "4.0.0"),
+					resource.TestCheckNoResourceAttr("zeet_project.test_terraform", "deploys.main.terraform_config.module_name"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithTerraformConfig(server.URL, "4.1.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_terraform", "deploys.main.terraform_config.source.module.version", "4.1.0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_project.test_terraform",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/" + testProjectId.String(),
+				ImportStateVerify: true,
+				// server side defaults are read on import
+				ImportStateVerifyIgnore: []string{"deploys.main.terraform_config.module_name"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithTerraformConfig(server string, version string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test_terraform" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = "one"
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  deploys = {
+    main = {
+      default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
+      terraform_config = {
+        provider = {
+          do_account_id = %[2]q
+          region        = "nyc1"
+        }
+        state_backend = {
+          s3_bucket = {
+            aws_account_id = %[2]q
+            bucket_name    = "terraform-state"
+            region         = "us-east-1"
+          }
+        }
+        source = {
+          module = {
+            source  = "terraform-aws-modules/s3-bucket/aws"
+            version = %[3]q
+          }
+        }
+        output_configuration = {
+          automatic_sensitive = true
+        }
+      }
+    }
+  }
+
+  workflow = {
+    steps: jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
+  }
+
+  enabled = true
+}
+`, server, testCloudId.String(), version)
+}
+
+// testAccProjectWorkflowServer serves a workflow project, the deploy configurations and workflow steps are stored as they
+// are sent and returned with the server side defaults of the helm, kubernetes and terraform targets and of the steps.
+func testAccProjectWorkflowServer(t *testing.T) *httptest.Server {
+	name := ""
+	var deploys []map[string]any
+	var steps []any
+	// every deploy change and workflow submission starts a workflow run
+	var runs []zeetv1.WorkflowRunsTeamProjectWorkflowRunsWorkflowRunConnectionNodesWorkflowRun
+	run := func() {
+		runs = append(runs, zeetv1.WorkflowRunsTeamProjectWorkflowRunsWorkflowRunConnectionNodesWorkflowRun{
+			WorkflowRunListItem: zeetv1.WorkflowRunListItem{
+				Id:       uuid.New(),
+				Sequence: len(runs) + 1,
+				Status:   zeetv1.WorkflowRunStatusCompleted,
+			},
+		})
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Id        uuid.UUID      `json:"id"`
+				ProjectId uuid.UUID      `json:"projectId"`
+				Input     map[string]any `json:"input"`
+			} `json:"variables"`
+		}
+		if err := json.Unmarshal(req, &body); err != nil {
+			t.Fatal(err)
+		}
+		reqs := string(req)
+		if strings.Contains(reqs, "mutation createProject") {
+			name = body.Variables.Input["name"].(string)
+			steps = body.Variables.Input["workflow"].(map[string]any)["steps"].([]any)
+			// the deploys are created one by one afterwards
+			deploys = nil
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.CreateProjectResponse{
+					CreateProject: zeetv1.CreateProjectCreateProject{
+						Id:   testProjectId,
+						Name: name,
+						Workflow: &zeetv1.CreateProjectCreateProjectWorkflow{
+							Id: testWorkflowId,
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "mutation updateProject") {
+			if n, ok := body.Variables.Input["name"].(string); ok {
+				name = n
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.UpdateProjectResponse{
+					UpdateProject: zeetv1.UpdateProjectUpdateProject{
+						Id:   testProjectId,
+						Name: name,
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "mutation updateDeploy") {
+			deploy, ok := lo.Find(deploys, func(d map[string]any) bool { return d["id"] == body.Variables.Id })
+			if !ok {
+				t.Fatalf("unexpected deploy %s", body.Variables.Id)
+			}
+			deploy["configuration"] = body.Variables.Input["configuration"]
+			run()
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"updateDeploy": map[string]any{"id": body.Variables.Id}},
+			})
+		} else if strings.Contains(reqs, "mutation createDeploy") && strings.Contains(reqs, "invalid: true") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"errors": []map[string]any{{"message": "invalid helm values"}},
+			})
+		} else if strings.Contains(reqs, "mutation createDeploy") {
+			deploy := map[string]any{"id": uuid.New(), "name": fmt.Sprintf("deploy-%d", len(deploys)), "configuration": body.Variables.Input["configuration"]}
+			if len(deploys) == 0 {
+				deploy["id"], deploy["name"] = testDeployId, "main"
+			}
+			deploys = append(deploys, deploy)
+			run()
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"createDeploy": lo.PickByKeys(deploy, []string{"id", "name"})},
+			})
+		} else if strings.Contains(reqs, "mutation deleteDeploy") {
+			deploys = lo.Reject(deploys, func(d map[string]any, _ int) bool { return d["id"] == body.Variables.Id })
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"deleteDeploy": true},
+			})
+		} else if strings.Contains(reqs, "query projectDetail") && body.Variables.ProjectId != testProjectId {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"team": map[string]any{"id": testTeamId, "project": nil}},
+			})
+		} else if strings.Contains(reqs, "query projectDetail") {
+			nodes := lo.Map(deploys, func(d map[string]any, _ int) map[string]any {
+				configuration := lo.Assign(d["configuration"].(map[string]any), map[string]any{"id": d["id"]})
+				if helm, ok := configuration["helm"].(map[string]any); ok {
+					// the input sets the target attributes chosen by Zeet to null
+					target := lo.Assign(helm["target"].(map[string]any))
+					for key, value := range map[string]any{"namespace": "default", "releaseName": name} {
+						if target[key] == nil {
+							target[key] = value
+						}
+					}
+					configuration["helm"] = lo.Assign(helm, map[string]any{"target": target})
+					if values, ok := helm["values"].(string); ok {
+						// the API returns the values reformatted
+						var document any
+						if err := yaml.Unmarshal([]byte(values), &document); err != nil {
+							t.Fatal(err)
+						}
+						out, err := yaml.Marshal(document)
+						if err != nil {
+							t.Fatal(err)
+						}
+						configuration["helm"].(map[string]any)["values"] = strings.TrimSpace(string(out))
+					}
+				}
+				if kubernetes, ok := configuration["kubernetes"].(map[string]any); ok {
+					target := lo.Assign(map[string]any{"namespace": "default"}, kubernetes["target"].(map[string]any))
+					configuration["kubernetes"] = lo.Assign(kubernetes, map[string]any{"target": target})
+				}
+				if terraform, ok := configuration["terraform"].(map[string]any); ok {
+					// the API returns the provider accounts as objects and empty objects for unset configurations
+					target := lo.Assign(map[string]any{"moduleName": name}, terraform["target"].(map[string]any))
+					provider := map[string]any{}
+					for key, value := range target["provider"].(map[string]any) {
+						if strings.HasSuffix(key, "AccountId") {
+							provider[strings.TrimSuffix(key, "Id")] = map[string]any{"id": value}
+						} else {
+							provider[key] = value
+						}
+					}
+					target["provider"] = provider
+					blueprint := lo.Assign(map[string]any{"outputConfiguration": map[string]any{}}, terraform["blueprint"].(map[string]any))
+					configuration["terraform"] = map[string]any{"target": target, "blueprint": blueprint}
+				}
+				return lo.Assign(d, map[string]any{"configuration": configuration})
+			})
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"team": map[string]any{
+						"id": testTeamId,
+						"project": map[string]any{
+							"id":        testProjectId,
+							"name":      name,
+							"status":    zeetv1.ProjectStatusJobRunSucceeded,
+							"workflow":  map[string]any{"id": testWorkflowId},
+							"group":     map[string]any{"id": testGroupId, "name": "group"},
+							"subGroup":  map[string]any{"id": testSubGroupId, "name": "subgroup"},
+							"blueprint": map[string]any{"id": "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"},
+							"deploys":   map[string]any{"nodes": nodes},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "mutation submitWorkflowRun") {
+			run()
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"submitWorkflow": map[string]any{"id": runs[len(runs)-1].Id}},
+			})
+		} else if strings.Contains(reqs, "query workflowRuns") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"team": map[string]any{
+						"project": map[string]any{
+							"workflow": map[string]any{"runs": map[string]any{"nodes": runs}},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query workflowRunDetail") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"team": map[string]any{
+						"project": map[string]any{
+							"workflow": map[string]any{"run": runs[len(runs)-1].WorkflowRunListItem},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "mutation updateWorkflow") {
+			steps = body.Variables.Input["definition"].(map[string]any)["steps"].([]any)
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"updateWorkflow": map[string]any{"id": testWorkflowId}},
+			})
+		} else if strings.Contains(reqs, "query projectWorkflowSteps") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"team": map[string]any{
+						"project": map[string]any{
+							"workflow": map[string]any{
+								"id": testWorkflowId,
+								"steps": lo.Map(steps, func(step any, _ int) map[string]any {
+									return lo.Assign(map[string]any{"disabled": false}, step.(map[string]any))
+								}),
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query projectV3") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.ProjectV3Response{
+					User: zeetv0.ProjectV3User{
+						ProjectV3Adapters: &zeetv0.ProjectV3UserProjectV3AdaptersProjectV3AdapterConnection{},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query blueprint (") {
+			json.NewEncoder(w).Encode(testAccBlueprintVariablesResponse())
+		} else if strings.Contains(reqs, "mutation deleteProject") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.DeleteProjectResponse{
+					DeleteProject: true,
+				},
+			})
+		} else {
+			t.Fatal("unexpected request")
+		}
+	}))
+}
+
+func testAccProjectContainerServer(t *testing.T) *httptest.Server {
+	name, runCommand := "one", "npm start"
+	cpu, memory := "1", "1G"
+	ports := []zeetv0.RepoNetworkPortsPort{
+		{
+			Port:     "3000",
+			Protocol: string(zeetv0.PortProtocolTcp),
+			Public:   true,
+			Https:    true,
+		},
+	}
+	productionBranch := "production"
+	groupId, subGroupId := testGroupId, testSubGroupId
+	var github *zeetv0.RepoDetailGithubIntegrationGitHubRepoIntegration
+	// every create or update rolls out a deployment, failing when the run command exits
+	var deployments []zeetv0.UserRepoDeploymentsCurrentUserRepoDeploymentsDeployment
+	deploy := func() {
+		status := zeetv0.DeploymentStatusDeploySucceeded
+		if strings.HasPrefix(runCommand, "exit") {
+			status = zeetv0.DeploymentStatusBuildFailed
+		}
+		deployments = append(deployments, zeetv0.UserRepoDeploymentsCurrentUserRepoDeploymentsDeployment{
+			Id: fmt.Sprintf("deployment-%d", len(deployments)),
+			DeploymentCommon: zeetv0.DeploymentCommon{
+				Id:        fmt.Sprintf("deployment-%d", len(deployments)),
+				Status:    status,
+				CreatedAt: time.Now(),
+			},
+		})
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reqs := string(req)
+		if strings.Contains(reqs, "mutation createResourceAlpha") && strings.Contains(reqs, "one") {
+			// create step 1
+			var body struct {
+				Variables struct {
+					Input zeetv0.CreateResourceAlphaInput `json:"input"`
+				} `json:"variables"`
+			}
+			if err := json.Unmarshal(req, &body); err != nil {
+				t.Fatal(err)
+			}
+			if branch := body.Variables.Input.Branch; branch != nil && branch.AutoDeployBranch != nil {
+				github = &zeetv0.RepoDetailGithubIntegrationGitHubRepoIntegration{
+					AutoDeployBranch: lo.FromPtr(branch.AutoDeployBranch),
+					AutoStopBranch:   lo.FromPtr(branch.AutoStopBranch),
+					BranchIgnore:     lo.FromPtr(branch.BranchIgnore),
+					BranchStopIgnore: lo.FromPtr(branch.BranchStopIgnore),
+				}
+			}
+			deploy()
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.CreateResourceAlphaResponse{
+					CreateResourceAlpha: zeetv0.CreateResourceAlphaCreateResourceAlphaRepo{
+						Name: "one",
+						Project: &zeetv0.CreateResourceAlphaCreateResourceAlphaRepoProject{
+							Name: "p",
+						},
+						ProjectEnvironment: &zeetv0.CreateResourceAlphaCreateResourceAlphaRepoProjectEnvironment{
+							Name: "e",
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query projectV3") {
+			// create step 2
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.ProjectV3Response{
+					User: zeetv0.ProjectV3User{
+						ProjectV3Adapters: &zeetv0.ProjectV3UserProjectV3AdaptersProjectV3AdapterConnection{
+							Nodes: []zeetv0.ProjectV3UserProjectV3AdaptersProjectV3AdapterConnectionNodesProjectV3Adapter{
+								{
+									ProjectV3AdapterDetail: zeetv0.ProjectV3AdapterDetail{
+										Id: testProjectId,
+										Repo: &zeetv0.ProjectV3AdapterDetailRepo{
+											Id: testRepoId.String(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "mutation updateProjectSettings") {
+			// update step 1
+			var body struct {
+				Variables struct {
+					Input zeetv0.UpdateProjectInput `json:"input"`
+				} `json:"variables"`
+			}
+			if err := json.Unmarshal(req, &body); err != nil {
+				t.Fatal(err)
+			}
+			name = lo.FromPtrOr(body.Variables.Input.Name, name)
+			runCommand = lo.FromPtrOr(body.Variables.Input.RunCommand, runCommand)
+			if resources := body.Variables.Input.Resources; resources != nil {
+				// the API may return kubernetes quantities
+				cpu = strconv.FormatFloat(resources.Cpu*1000, 'f', -1, 64) + "m"
+				memory = strconv.FormatFloat(resources.Memory*1000, 'f', -1, 64) + "M"
+			}
+			productionBranch = lo.FromPtrOr(body.Variables.Input.ProductionBranch, productionBranch)
+			if integration := body.Variables.Input.GithubIntegration; integration != nil {
+				github.AutoDeployBranch = lo.FromPtrOr(integration.AutoDeployBranch, github.AutoDeployBranch)
+				github.AutoStopBranch = lo.FromPtrOr(integration.AutoStopBranch, github.AutoStopBranch)
+				github.BranchIgnore = lo.FromPtrOr(integration.BranchIgnore, github.BranchIgnore)
+				github.BranchStopIgnore = lo.FromPtrOr(integration.BranchStopIgnore, github.BranchStopIgnore)
+			}
+			if body.Variables.Input.Ports != nil {
+				if err := json.Unmarshal([]byte(*body.Variables.Input.Ports), &ports); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// renaming a project doesn't roll out a deployment
+			if !reflect.DeepEqual(body.Variables.Input, zeetv0.UpdateProjectInput{Id: body.Variables.Input.Id, Name: body.Variables.Input.Name}) {
+				deploy()
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.UpdateProjectSettingsResponse{
+					UpdateProject: zeetv0.UpdateProjectSettingsUpdateProjectRepo{
+						Id: testRepoId.String(),
+						RepoDetail: zeetv0.RepoDetail{
+							RepoCommon: zeetv0.RepoCommon{
+								Name: name,
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query groupSubGroups") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.GroupSubGroupsResponse{
+					Team: &zeetv1.GroupSubGroupsTeam{
+						Groups: zeetv1.GroupSubGroupsTeamGroupsGroupConnection{
+							Nodes: []zeetv1.GroupSubGroupsTeamGroupsGroupConnectionNodesGroup{
+								{
+									Id: groupId,
+									SubGroups: []zeetv1.GroupSubGroupsTeamGroupsGroupConnectionNodesGroupSubGroupsSubGroup{
+										{
+											Id:   subGroupId,
+											Name: "e",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query group") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.GroupResponse{
+					Team: &zeetv1.GroupTeam{
+						Groups: zeetv1.GroupTeamGroupsGroupConnection{
+							Nodes: []zeetv1.GroupTeamGroupsGroupConnectionNodesGroup{
+								{
+									Id:   groupId,
+									Name: "p",
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query projectDetail") {
+			// import
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv1.ProjectDetailResponse{
+					Team: &zeetv1.ProjectDetailTeam{
+						Project: &zeetv1.ProjectDetailTeamProject{
+							ProjectDetail: zeetv1.ProjectDetail{
+								ProjectInfo: zeetv1.ProjectInfo{
+									Id:   testProjectId,
+									Name: name,
+								},
+								Blueprint: &zeetv1.ProjectDetailBlueprint{
+									ProjectBlueprintDetail: zeetv1.ProjectBlueprintDetail{
+										Id: uuid.MustParse("5a0e108d-6df6-456d-aa3a-a89e78b57cf6"),
+									},
+								},
+								Group: &zeetv1.ProjectDetailGroup{
+									Id:   groupId,
+									Name: "p",
+								},
+								SubGroup: &zeetv1.ProjectDetailSubGroup{
+									Id:   subGroupId,
+									Name: "e",
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query userRepoDeployments") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.UserRepoDeploymentsResponse{
+					CurrentUser: zeetv0.UserRepoDeploymentsCurrentUser{
+						Repo: &zeetv0.UserRepoDeploymentsCurrentUserRepo{
+							Id:          testRepoId.String(),
+							Deployments: deployments,
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query deploymentBuildLogs") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.DeploymentBuildLogsResponse{
+					CurrentUser: zeetv0.DeploymentBuildLogsCurrentUser{
+						Deployment: &zeetv0.DeploymentBuildLogsCurrentUserDeployment{
+							DeploymentBuildLogs: zeetv0.DeploymentBuildLogs{
+								Build: &zeetv0.DeploymentBuildLogsBuild{
+									ErrorMessage: lo.ToPtr("run command failed"),
+									Logs: &zeetv0.DeploymentBuildLogsBuildLogs{
+										Entries: []zeetv0.DeploymentBuildLogsBuildLogsEntriesLogEntry{
+											{Text: "> " + runCommand},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		} else if strings.Contains(reqs, "query userRepo") {
+			data := zeetv0.UserRepoResponse{
+				CurrentUser: zeetv0.UserRepoCurrentUser{
+					Repo: &zeetv0.UserRepoCurrentUserRepo{
+						Id: testRepoId.String(),
+						RepoDetail: zeetv0.RepoDetail{
+							DeployService: lo.ToPtr(true),
+							RepoCommon: zeetv0.RepoCommon{
+								Id:      testRepoId.String(),
+								Name:    name,
+								Enabled: true,
+								Source: zeetv0.RepoCommonSourceRepoSource{
+									Id:   "https://github.com/zeet-demo/node-express-demo.git",
+									Type: zeetv0.RepoSourceTypeGit,
+								},
+							},
+							RepoBuild: zeetv0.RepoBuild{
+								BuildMethod: &zeetv0.RepoBuildBuildMethod{
+									Type:             zeetv0.BuildTypeNode,
+									BuildCommand:     lo.ToPtr("npm --production=false install"),
+									RunCommand:       lo.ToPtr(runCommand),
+									WorkingDirectory: lo.ToPtr("./"),
+									NodejsVersion:    lo.ToPtr("18"),
+								},
+							},
+							GithubIntegration: github,
+							ProductionBranch:  lo.ToPtr(productionBranch),
+							DeployTarget:      lo.ToPtr(zeetv0.DeployTargetKubernetes),
+							Cluster: &zeetv0.RepoDetailCluster{
+								Id: testClusterId,
+							},
+							Project: &zeetv0.RepoDetailProject{
+								Id: groupId,
+							},
+							ProjectEnvironment: &zeetv0.RepoDetailProjectEnvironment{
+								Id: subGroupId,
+							},
+							RepoNetwork: zeetv0.RepoNetwork{
+								Ports: ports,
+							},
+							Cpu:       lo.ToPtr(cpu),
+							Memory:    lo.ToPtr(memory),
+							Dedicated: lo.ToPtr(false),
+						},
+					},
+				},
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": data,
+			})
+		} else if strings.Contains(reqs, "mutation updateProjectDangerSettings") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"updateProjectDanger": map[string]any{"id": testRepoId}},
+			})
+		} else if strings.Contains(reqs, "mutation setRepoEnvs") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"setRepoEnvs": map[string]any{"id": testRepoId}},
+			})
+		} else if strings.Contains(reqs, "mutation moveRepo") {
+			var body struct {
+				Variables struct {
+					Input zeetv0.MoveRepoInput `json:"input"`
+				} `json:"variables"`
+			}
+			if err := json.Unmarshal(req, &body); err != nil {
+				t.Fatal(err)
+			}
+			groupId = lo.FromPtrOr(body.Variables.Input.ProjectID, groupId)
+			subGroupId = lo.FromPtrOr(body.Variables.Input.EnvironmentID, subGroupId)
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.MoveRepoResponse{},
+			})
+		} else if strings.Contains(reqs, "mutation deleteProject") {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": zeetv0.DeleteProjectResponse{
+					DeleteRepo: true,
+				},
+			})
+		} else {
+			t.Fatal("unexpected request", reqs[:42])
+		}
+	}))
+}
+
+func TestAccProjectResourceContainer(t *testing.T) {
+	server := testAccProjectContainerServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerDeployment(server.URL, "one", testClusterId.String(), "npm start"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "name", "one"),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "team_id", testTeamId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.repo_id", testRepoId.String()),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerDeployment(server.URL, "two", testClusterId.String(), "npm run serve"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "name", "two"),
+					resource.TestCheckResourceAttrWith("zeet_project.test_container", "container.build", func(value string) error {
+						if !strings.Contains(value, `"runCommand":"npm run serve"`) {
+							return fmt.Errorf("unexpected build %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Moving from the kubernetes JSON to kubernetes_config on the same cluster updates the project in place
+			{
+				Config: testAccProjectResourceConfigWithContainerKubernetesConfig(server.URL, "two", testClusterId.String(), "3000", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeet_project.test_container", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.kubernetes_config.deploy_target.cluster_id", testClusterId.String()),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithContainerDeployment(server string, name string, clusterID string, runCommand string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test_container" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = %[2]q
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  container = {
+    source = {
+      git = jsonencode({
+        repository: "https://github.com/zeet-demo/node-express-demo.git"
+      })
+    }
+    build = jsonencode({
+      build = {
+        buildType: "NODE",
+        buildCommand: "npm --production=false install",
+        nodejsVersion: "18",
+        runCommand: %[4]q,
+        workingDirectory: "./"
+      }
+    })
+    kubernetes = jsonencode({
+      deployTarget = {
+        deployTarget: "KUBERNETES",
+        clusterID: %[3]q
+      },
+      app = {
+        deployService: true,
+        ports: [
+          {
+            port: "3000",
+            protocol: "tcp",
+            public: true,
+            https: true
+          }
+        ],
+        resources = {
+          cpu: 1,
+          memory: 1,
+          spot: true
+        }
+      }
+    })
+  }
+
+  enabled = true
+}
+`, server, name, clusterID, runCommand)
+}
+
+func TestAccProjectResourceContainerKubernetesConfig(t *testing.T) {
+	server := testAccProjectContainerServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerKubernetesConfig(server.URL, "one", testClusterId.String(), "3000", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "name", "one"),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.repo_id", testRepoId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.kubernetes_config.deploy_target.cluster_id", testClusterId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.kubernetes_config.app.ports.0.port", "3000"),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.kubernetes_config.app.resources.cpu", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerKubernetesConfig(server.URL, "one", testClusterId.String(), "8080", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.kubernetes_config.app.ports.0.port", "8080"),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.kubernetes_config.app.resources.cpu", "2"),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.kubernetes_config.app.resources.memory", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zeet_project.test_container",
+				ImportState:       true,
+				ImportStateId:     testTeamId.String() + "/" + testProjectId.String(),
+				ImportStateVerify: true,
+				// build is read back with every server side field
+				ImportStateVerifyIgnore: []string{"container.build"},
+			},
+			// ImportState by name testing
+			{
+				ResourceName:            "zeet_project.test_container",
+				ImportState:             true,
+				ImportStateId:           testTeamId.String() + "/" + testGroupId.String() + "/" + testSubGroupId.String() + "/one",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"container.build"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithContainerKubernetesConfig(server string, name string, clusterID string, port string, size int) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test_container" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = %[2]q
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  container = {
+    source = {
+      git = jsonencode({
+        repository: "https://github.com/zeet-demo/node-express-demo.git"
+      })
+    }
+    build = jsonencode({
+      build = {
+        buildType: "NODE",
+        buildCommand: "npm --production=false install",
+        nodejsVersion: "18",
+        runCommand: "npm start",
+        workingDirectory: "./"
+      }
+    })
+    kubernetes_config = {
+      deploy_target = {
+        cluster_id = %[3]q
+      }
+      app = {
+        deploy_service = true
+        ports = [{
+          port   = %[4]q
+          public = true
+          https  = true
+        }]
+        resources = {
+          cpu    = %[5]d
+          memory = %[5]d
+          spot   = true
+        }
+      }
+    }
+  }
+
+  enabled = true
+}
+`, server, name, clusterID, port, size)
+}
+
+func TestAccProjectResourceContainerBranch(t *testing.T) {
+	server := testAccProjectContainerServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerBranch(server.URL, `
+    branch = {
+      auto_deploy_branch = true
+      auto_stop_branch = true
+      branch_ignore = "main"
+      branch_stop_ignore = ""
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.branch.auto_deploy_branch", "true"),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.branch.branch_ignore", "main"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerBranch(server.URL, `
+    branch = {
+      auto_deploy_branch = false
+      auto_stop_branch = true
+      branch_ignore = "dependabot/*"
+      branch_stop_ignore = ""
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.branch.auto_deploy_branch", "false"),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.branch.branch_ignore", "dependabot/*"),
+				),
+			},
+			// Removed settings are cleared
+			{
+				Config: testAccProjectResourceConfigWithContainerBranch(server.URL, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "container.branch.production_branch", "production"),
+					resource.TestCheckNoResourceAttr("zeet_project.test_container", "container.branch.auto_stop_branch"),
+					resource.TestCheckNoResourceAttr("zeet_project.test_container", "container.branch.branch_ignore"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithContainerBranch(server string, branch string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test_container" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = "one"
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  container = {
+    source = {
+      git = jsonencode({
+        repository: "https://github.com/zeet-demo/node-express-demo.git"
+      })
+    }
+%[2]s
+    kubernetes_config = {
+      deploy_target = {
+        cluster_id = %[3]q
+      }
+    }
+  }
+
+  enabled = true
+}
+`, server, branch, testClusterId.String())
+}
+
+func TestAccProjectResourceContainerMove(t *testing.T) {
+	server := testAccProjectContainerServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerSubGroup(server.URL, testSubGroupId.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "subgroup_id", testSubGroupId.String()),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigWithContainerSubGroup(server.URL, testSubGroupId2.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "id", testProjectId.String()),
+					resource.TestCheckResourceAttr("zeet_project.test_container", "subgroup_id", testSubGroupId2.String()),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithContainerSubGroup(server string, subGroupID string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = %[1]q
+}
+
+resource "zeet_project" "test_container" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = %[2]q
+
+  name = "one"
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+
+  container = {
+    source = {
+      git = jsonencode({
+        repository: "https://github.com/zeet-demo/node-express-demo.git"
+      })
+    }
+    kubernetes_config = {
+      deploy_target = {
+        cluster_id = %[3]q
+      }
+    }
+  }
+
+  enabled = true
+}
+`, server, subGroupID, testClusterId.String())
+}
+
+func TestAccProjectResourceContainerWaitForDeploy(t *testing.T) {
+	server := testAccProjectContainerServer(t)
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create waits for the deployment to succeed
+			{
+				Config: testAccProjectResourceConfigWithContainerWaitForDeploy(server.URL, "one", "npm start"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "wait_for_deploy", "true"),
+				),
+			},
+			// Update doesn't wait when no deployment is expected, the update timeout is shorter than the grace period
+			{
+				Config: testAccProjectResourceConfigWithContainerWaitForDeploy(server.URL, "two", "npm start"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zeet_project.test_container", "name", "two"),
+				),
+			},
+			// Update fails with the deployment failure
+			{
+				Config:      testAccProjectResourceConfigWithContainerWaitForDeploy(server.URL, "two", "exit 1"),
+				ExpectError: regexp.MustCompile(`(?s)Deployment Failed.*BUILD_FAILED: run command failed.*> exit 1`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithContainerWaitForDeploy(server string, name string, runCommand string) string {
+	return strings.Replace(testAccProjectResourceConfigWithContainerDeployment(server, name, testClusterId.String(), runCommand),
+		"enabled = true", "enabled = true\n  wait_for_deploy = true\n  timeouts {\n    update = \"5s\"\n  }", 1)
+}
+
+func TestAccProjectResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Neither container nor workflow
+			{
+				Config:      testAccProjectResourceInvalidConfig(``),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[container,workflow\]`),
+			},
+			// Workflow without deploys
+			{
+				Config: testAccProjectResourceInvalidConfig(`
+  workflow = {
+    steps = jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Invalid Attribute Combination.*\[workflow,deploys\]`),
+			},
+			// Workflow with an empty map of deploys
+			{
+				Config: testAccProjectResourceInvalidConfig(`
+  deploys = {}
+  workflow = {
+    steps = jsonencode([{ action: "ORCHESTRATION_DEPLOY" }])
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute deploys map must contain at least 1 elements`),
+			},
+			// Container with deploys
+			{
+				Config: testAccProjectResourceInvalidConfig(`
+  deploys = {
+    main = {
+      default_workflow_steps = ["DRIVER_APPLY"]
+    }
+  }
+  container = {
+    source = {
+      git = jsonencode({ repository: "https://github.com/zeet-demo/node-express-demo.git" })
+    }
+    kubernetes = jsonencode({})
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Invalid Attribute Combination.*\[container,deploys\]`),
+			},
+			// Container with both a git and a container registry source
+			{
+				Config: testAccProjectResourceInvalidConfig(`
+  container = {
+    source = {
+      git = jsonencode({ repository: "https://github.com/zeet-demo/node-express-demo.git" })
+      container_registry = jsonencode({ repository: "docker.io/library/nginx" })
+    }
+    kubernetes = jsonencode({})
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)2 attributes specified when one \(and only one\) of\s+\[container\.source\.git,container\.source\.container_registry\]`),
+			},
+			// Container without a source
+			{
+				Config: testAccProjectResourceInvalidConfig(`
+  container = {
+    source = {}
+    kubernetes = jsonencode({})
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No attribute specified when one \(and only one\) of\s+\[container\.source\.git,container\.source\.container_registry\]`),
+			},
+			// Waiting for a workflow run of a container project
+			{
+				Config: testAccProjectResourceInvalidConfig(`
+  container = {
+    source = {
+      git = jsonencode({ repository: "https://github.com/zeet-demo/node-express-demo.git" })
+    }
+    kubernetes = jsonencode({})
+  }
+  wait_for_workflow_run = true
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Attribute "container" cannot be specified when "wait_for_workflow_run" is\s+specified`),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceInvalidConfig(body string) string {
+	return fmt.Sprintf(`
+provider "zeet" {
+  api_url = "http://localhost"
+}
+
+resource "zeet_project" "test_invalid" {
+  team_id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+  group_id = "ddf9093e-cc11-46a5-82c7-fc99fc44ef93"
+  subgroup_id = "149ad8a9-cb35-477b-bbac-39a39f146074"
+
+  name = "invalid"
+  blueprint_id = "5a0e108d-6df6-456d-aa3a-a89e78b57cf6"
+%s
+  enabled = true
+}
+`, body)
+}
+
+func TestAccProjectResourceBlueprintVariables(t *testing.T) {
+	blueprintUnavailable := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(req), "query blueprint (") && blueprintUnavailable {
+			json.NewEncoder(w).Encode(map[string]any{
+				"errors": []map[string]any{{"message": "blueprint is unavailable"}},
+			})
+		} else if strings.Contains(string(req), "query blueprint (") {
+			json.NewEncoder(w).Encode(testAccBlueprintVariablesResponse(
+				map[string]any{"id": uuid.New(), "name": "replicas", "type": "INTEGER", "required": true},
+				map[string]any{"id": uuid.New(), "name": "debug", "type": "BOOLEAN", "required": false},
+			))
+		} else {
+			t.Fatal("unexpected request")
+		}
+	}))
+	defer server.Close()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Valid variables
+			{
+				Config:             testAccProjectResourceConfigWithVariables(server.URL, `[{ name: "replicas", value: "2" }, { name: "region", value: "us-east-1", type: "STRING" }]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Missing required variable
+			{
+				Config:      testAccProjectResourceConfigWithVariables(server.URL, `[{ name: "debug", value: "true" }]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Variable "replicas" is required by the blueprint`),
+			},
+			// Wrong value type
+			{
+				Config:      testAccProjectResourceConfigWithVariables(server.URL, `[{ name: "replicas", value: "two" }]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Variable "replicas" \(index 0\) is not a valid INTEGER value, got: "two"`),
+			},
+			// Wrong declared type
+			{
+				Config:      testAccProjectResourceConfigWithVariables(server.URL, `[{ name: "replicas", value: "2", type: "STRING" }]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Variable "replicas" \(index 0\) is declared as STRING but the blueprint defines\s+it as INTEGER`),
+			},
+			// Unknown variable
+			{
+				Config:      testAccProjectResourceConfigWithVariables(server.URL, `[{ name: "replicas", value: "2" }, { name: "region", value: "us-east-1" }]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Variable "region" \(index 1\) is not defined by the blueprint`),
+			},
+			// Variables are not validated when the blueprint can't be read
+			{
+				PreConfig:          func() { blueprintUnavailable = true },
+				Config:             testAccProjectResourceConfigWithVariables(server.URL, `[{ name: "replicas", value: "two" }]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigWithVariables(server string, variables string) string {
+	return strings.Replace(testAccProjectResourceConfigWithHelmDeployment(server, "one", testClusterId.String()),
+		`default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]`,
+		`default_workflow_steps = ["DRIVER_PLAN", "DRIVER_APPROVE", "DRIVER_APPLY"]
+	variables = jsonencode(`+variables+`)`, 1)
+}
+
+// testAccBlueprintVariablesResponse is the response of the blueprint query for a blueprint with the given variable specs.
+func testAccBlueprintVariablesResponse(variables ...map[string]any) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"currentUser": map[string]any{"id": "99c11487-1683-4e10-9620-94d9a78a0b67"},
+			"user": map[string]any{
+				"id": "99c11487-1683-4e10-9620-94d9a78a0b67",
+				"blueprint": map[string]any{
+					"id":        "5a0e108d-6df6-456d-aa3a-a89e78b57cf6",
+					"type":      "HELM",
+					"variables": variables,
+				},
+			},
+		},
+	}
 }
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+)
+
+var _ resource.ResourceWithUpgradeState = &ProjectResource{}
+
+// UpgradeState upgrades the state of projects written by earlier versions of the provider.
+func (r *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: r.upgradeProjectStateV0,
+		},
+	}
+}
+
+// upgradeProjectStateV0 keys the deploys of the version 0 list by their name in Zeet, the same keys as an import, so
+// the configuration must be migrated from the `deploys` list to a map keyed by these names. The deploys whose name
+// can't be read are keyed by their former list index. The rest of the state is unchanged so the prior state is
+// transformed as JSON instead of redeclaring the whole version 0 schema.
+func (r *ProjectResource) upgradeProjectStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The prior state is not in JSON format")
+		return
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to unmarshal the prior state, got error: %s", err))
+		return
+	}
+	if deploys, ok := state["deploys"].([]any); ok {
+		names, err := r.deployNames(ctx, state)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to Read Deploy Names",
+				fmt.Sprintf("The deploys are keyed by their former list index, got error: %s", err))
+		}
+		keyed := map[string]any{}
+		for i, deploy := range deploys {
+			key := strconv.Itoa(i)
+			if id, ok := deploy.(map[string]any)["id"].(string); ok && names[id] != "" {
+				key = names[id]
+			}
+			keyed[key] = deploy
+		}
+		state["deploys"] = keyed
+	}
+
+	stateJson, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to marshal the upgraded state, got error: %s", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{
+		JSON: stateJson,
+	}
+}
+
+// deployNames returns the names of the deploys of the project in the prior state, by deploy identifier.
+func (r *ProjectResource) deployNames(ctx context.Context, state map[string]any) (map[string]string, error) {
+	if r.client == nil {
+		return nil, fmt.Errorf("the provider is not configured")
+	}
+	teamId, err := uuid.Parse(fmt.Sprint(state["team_id"]))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse team_id: %w", err)
+	}
+	projectId, err := uuid.Parse(fmt.Sprint(state["id"]))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse id: %w", err)
+	}
+
+	result, err := zeetv1.ProjectDetailQuery(ctx, r.client.ClientV1(), teamId, projectId)
+	if err != nil {
+		return nil, err
+	}
+	if result.Team == nil || result.Team.Project == nil {
+		return nil, fmt.Errorf("project not found")
+	}
+	names := map[string]string{}
+	for _, node := range result.Team.Project.Deploys.Nodes {
+		names[node.Id.String()] = node.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,145 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+)
+
+// The state upgrade of version 0 can't be exercised by an acceptance test step, the upgrader is called through the
+// provider server instead.
+func TestProjectResourceUpgradeStateV0(t *testing.T) {
+	otherDeployId := uuid.MustParse("8f0b7f4e-2c1d-4f6a-9b3e-5d7c9a1e2f30")
+	unknownDeployId := uuid.MustParse("c3a1d5e7-9b2f-4d8c-a6e4-1f3b5d7c9e02")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query string `json:"query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(req.Query, "query projectDetail") {
+			t.Fatal("unexpected request")
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"team": map[string]any{
+					"id": testTeamId,
+					"project": map[string]any{
+						"id":   testProjectId,
+						"name": "one",
+						"deploys": map[string]any{"nodes": []any{
+							// the nodes are not in the order of the prior state
+							map[string]any{"id": otherDeployId, "name": "grafana"},
+							map[string]any{"id": testDeployId, "name": "main"},
+						}},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	priorState := map[string]any{
+		"id":      testProjectId,
+		"team_id": testTeamId,
+		"name":    "one",
+		"deploys": []any{
+			map[string]any{"id": testDeployId, "helm": `{"values":"replicas: 1"}`},
+			map[string]any{"id": otherDeployId, "helm": `{"values":"replicas: 2"}`},
+			// deleted in Zeet
+			map[string]any{"id": unknownDeployId},
+		},
+	}
+
+	deploys := testUpgradeProjectStateV0(t, server.URL, priorState)
+	if len(deploys) != 3 {
+		t.Fatalf("expected 3 deploys, got %d", len(deploys))
+	}
+	for key, id := range map[string]uuid.UUID{"main": testDeployId, "grafana": otherDeployId, "2": unknownDeployId} {
+		deploy, ok := deploys[key]
+		if !ok {
+			t.Errorf("expected deploy %q, got %v", key, lo.Keys(deploys))
+			continue
+		}
+		var attributes map[string]tftypes.Value
+		if err := deploy.As(&attributes); err != nil {
+			t.Fatal(err)
+		}
+		var deployId string
+		if err := attributes["id"].As(&deployId); err != nil {
+			t.Fatal(err)
+		}
+		if deployId != id.String() {
+			t.Errorf("expected deploy %q to be %s, got %s", key, id, deployId)
+		}
+	}
+}
+
+// testUpgradeProjectStateV0 configures the provider with the API URL and returns the deploys of the upgraded state.
+func testUpgradeProjectStateV0(t *testing.T, apiUrl string, priorState map[string]any) map[string]tftypes.Value {
+	ctx := context.Background()
+	server, err := testAccProtoV6ProviderFactories["zeet"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configType := schemas.Provider.ValueType().(tftypes.Object)
+	config := lo.MapValues(configType.AttributeTypes, func(attributeType tftypes.Type, _ string) tftypes.Value {
+		return tftypes.NewValue(attributeType, nil)
+	})
+	config["api_url"] = tftypes.NewValue(tftypes.String, apiUrl)
+	configValue, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	configured, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &configValue})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range configured.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	priorStateJson, err := json.Marshal(priorState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upgraded, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "zeet_project",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: priorStateJson},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range upgraded.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	state, err := upgraded.UpgradedState.Unmarshal(schemas.ResourceSchemas["zeet_project"].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	var deploys map[string]tftypes.Value
+	if err := attributes["deploys"].As(&deploys); err != nil {
+		t.Fatalf("unable to read deploys: %s", err)
+	}
+	return deploys
+}
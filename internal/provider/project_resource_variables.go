@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
+)
+
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
+
+// ModifyPlan validates the variables of the deploys against the variable specs of the project blueprint, the
+// provider is not configured yet when the configuration is validated so this can only happen at plan time.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy or when nothing changes
+	if r.client == nil || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var teamId, blueprintId customtypes.UUIDValue
+	var deploys types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &teamId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("blueprint_id"), &blueprintId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deploys"), &deploys)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if teamId.IsUnknown() || blueprintId.IsNull() || blueprintId.IsUnknown() || deploys.IsNull() || deploys.IsUnknown() {
+		return
+	}
+
+	// the v1 blueprint query does not select the variable specs, the API validates the variables on apply
+	// when they can't be validated here
+	result, err := zeetv0.BlueprintQuery(ctx, r.client.Client(), teamId.ValueUUID().String(), teamId.ValueUUID(), blueprintId.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("blueprint_id"), "Unable to Validate Blueprint Variables",
+			fmt.Sprintf("Unable to read blueprint, got error: %s", err))
+		return
+	}
+	if result.User.Blueprint == nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("blueprint_id"), "Unable to Validate Blueprint Variables", "Blueprint not found")
+		return
+	}
+
+	for name, deploy := range deploys.Elements() {
+		if deploy.IsUnknown() {
+			continue
+		}
+		variablesPath := path.Root("deploys").AtMapKey(name).AtName("variables")
+
+		var variables jsontypes.Normalized
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, variablesPath, &variables)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if variables.IsUnknown() {
+			continue
+		}
+
+		validateBlueprintVariables(variablesPath, variables, result.User.Blueprint.Variables, &resp.Diagnostics)
+	}
+}
+
+// validateBlueprintVariables checks that the variables are defined by the blueprint, that their values match the
+// types of the variables and that the required variables are set. The variables are a single JSON attribute,
+// diagnostics point at it and name the offending variable with its index.
+func validateBlueprintVariables(p path.Path, variables jsontypes.Normalized, specs []zeetv0.BlueprintListVariablesBlueprintVariableSpec, diags *diag.Diagnostics) {
+	var inputs []zeetv1.BlueprintVariableInput
+	if !variables.IsNull() {
+		if err := json.Unmarshal([]byte(variables.ValueString()), &inputs); err != nil {
+			diags.AddAttributeError(p, "Invalid Configuration", fmt.Sprintf("Unable to unmarshal variables, got error: %s", err))
+			return
+		}
+	}
+
+	set := map[uuid.UUID]bool{}
+	for i, input := range inputs {
+		name := blueprintVariableName(input, i)
+
+		spec, ok := lo.Find(specs, func(spec zeetv0.BlueprintListVariablesBlueprintVariableSpec) bool {
+			return (input.SpecId != nil && *input.SpecId == spec.Id) || (input.SpecId == nil && input.Name != nil && *input.Name == spec.Name)
+		})
+		if !ok {
+			// variables not defined by the blueprint must be declared with their type
+			if input.Type == nil {
+				diags.AddAttributeError(p, "Invalid Blueprint Variable",
+					fmt.Sprintf("Variable %s is not defined by the blueprint, set its type to declare a new variable", name))
+				continue
+			}
+			if !validBlueprintVariableValue(*input.Type, input.Value) {
+				diags.AddAttributeError(p, "Invalid Blueprint Variable", fmt.Sprintf("Variable %s is not a valid %s value, got: %q", name, *input.Type, input.Value))
+			}
+			continue
+		}
+		set[spec.Id] = true
+
+		variableType := zeetv1.BlueprintVariableType(spec.Type)
+		if input.Type != nil && *input.Type != variableType {
+			diags.AddAttributeError(p, "Invalid Blueprint Variable",
+				fmt.Sprintf("Variable %s is declared as %s but the blueprint defines it as %s", name, *input.Type, variableType))
+			continue
+		}
+		if !validBlueprintVariableValue(variableType, input.Value) {
+			diags.AddAttributeError(p, "Invalid Blueprint Variable", fmt.Sprintf("Variable %s is not a valid %s value, got: %q", name, variableType, input.Value))
+		}
+	}
+
+	for _, spec := range specs {
+		if spec.Required && !set[spec.Id] {
+			diags.AddAttributeError(p, "Invalid Blueprint Variable", fmt.Sprintf("Variable %q is required by the blueprint", spec.Name))
+		}
+	}
+}
+
+// blueprintVariableName identifies the i-th variable input in diagnostics.
+func blueprintVariableName(input zeetv1.BlueprintVariableInput, i int) string {
+	switch {
+	case input.Name != nil:
+		return fmt.Sprintf("%q (index %d)", *input.Name, i)
+	case input.SpecId != nil:
+		return fmt.Sprintf("%s (index %d)", input.SpecId, i)
+	default:
+		return fmt.Sprintf("at index %d", i)
+	}
+}
+
+func validBlueprintVariableValue(variableType zeetv1.BlueprintVariableType, value string) bool {
+	var err error
+	switch variableType {
+	case zeetv1.BlueprintVariableTypeBoolean:
+		_, err = strconv.ParseBool(value)
+	case zeetv1.BlueprintVariableTypeInteger:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case zeetv1.BlueprintVariableTypeFloat:
+		_, err = strconv.ParseFloat(value, 64)
+	case zeetv1.BlueprintVariableTypeJson:
+		return json.Valid([]byte(value))
+	}
+	return err == nil
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	zeetv0 "github.com/zeet-dev/cli/pkg/sdk/v0"
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+)
+
+const (
+	// waitPollInterval is the delay between two status checks of a deployment or workflow run
+	waitPollInterval = 10 * time.Second
+	// waitStartGracePeriod is how long to wait for a change expected to roll out to start a new deployment or
+	// workflow run, the API may start it asynchronously
+	waitStartGracePeriod = time.Minute
+	// maxFailureLogLines is the number of log lines included in a failure diagnostic
+	maxFailureLogLines = 20
+)
+
+// waitFailedError is returned when the awaited deployment or workflow run failed.
+type waitFailedError struct {
+	summary string
+	detail  string
+}
+
+func (e *waitFailedError) Error() string {
+	return e.detail
+}
+
+// poll calls check until it is done, it returns an error or ctx expires.
+func poll(ctx context.Context, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitPollInterval):
+		}
+	}
+}
+
+// latestDeploymentId returns the identifier of the most recent deployment of a container project, or an empty string.
+func latestDeploymentId(ctx context.Context, client *zeetClient, repoId uuid.UUID) (string, error) {
+	deployment, err := latestDeployment(ctx, client, repoId)
+	if err != nil || deployment == nil {
+		return "", err
+	}
+	return deployment.Id, nil
+}
+
+func latestDeployment(ctx context.Context, client *zeetClient, repoId uuid.UUID) (*zeetv0.UserRepoDeploymentsCurrentUserRepoDeploymentsDeployment, error) {
+	result, err := zeetv0.UserRepoDeploymentsQuery(ctx, client.Client(), repoId.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if result.CurrentUser.Repo == nil || len(result.CurrentUser.Repo.Deployments) == 0 {
+		return nil, nil
+	}
+	latest := lo.MaxBy(result.CurrentUser.Repo.Deployments, func(a, b zeetv0.UserRepoDeploymentsCurrentUserRepoDeploymentsDeployment) bool {
+		return a.CreatedAt.After(b.CreatedAt)
+	})
+	return &latest, nil
+}
+
+// waitForDeployment waits for the deployment started after previousId to finish, it returns a *waitFailedError
+// when the deployment fails. It doesn't wait when no deployment started within startTimeout.
+func waitForDeployment(ctx context.Context, client *zeetClient, repoId uuid.UUID, previousId string, startTimeout time.Duration) error {
+	start := time.Now()
+
+	return poll(ctx, func() (bool, error) {
+		deployment, err := latestDeployment(ctx, client, repoId)
+		if err != nil {
+			return false, err
+		}
+		if deployment == nil || deployment.Id == previousId {
+			if time.Since(start) >= startTimeout {
+				tflog.Warn(ctx, "No deployment started, not waiting")
+				return true, nil
+			}
+			return false, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for deployment", map[string]any{"id": deployment.Id, "status": deployment.Status})
+		switch deployment.Status {
+		case zeetv0.DeploymentStatusDeploySucceeded, zeetv0.DeploymentStatusDeployHealhty:
+			return true, nil
+		case zeetv0.DeploymentStatusBuildFailed, zeetv0.DeploymentStatusBuildAborted, zeetv0.DeploymentStatusDeployFailed,
+			zeetv0.DeploymentStatusDeployStopped, zeetv0.DeploymentStatusDeployCrashing:
+			return true, deploymentFailure(ctx, client, deployment)
+		default:
+			return false, nil
+		}
+	})
+}
+
+func deploymentFailure(ctx context.Context, client *zeetClient, deployment *zeetv0.UserRepoDeploymentsCurrentUserRepoDeploymentsDeployment) error {
+	reasons := []string{}
+	if deployment.ErrorMessage != nil {
+		reasons = append(reasons, *deployment.ErrorMessage)
+	}
+	if deployment.DeployStatus != nil && deployment.DeployStatus.ErrorMessage != nil {
+		reasons = append(reasons, *deployment.DeployStatus.ErrorMessage)
+	}
+
+	// logs are best effort, the failure is reported without them
+	var logs []string
+	if strings.HasPrefix(string(deployment.Status), "BUILD_") {
+		if result, err := zeetv0.DeploymentBuildLogsQuery(ctx, client.Client(), deployment.Id); err == nil &&
+			result.CurrentUser.Deployment != nil && result.CurrentUser.Deployment.Build != nil {
+			build := result.CurrentUser.Deployment.Build
+			if build.ErrorMessage != nil {
+				reasons = append(reasons, *build.ErrorMessage)
+			}
+			if build.Logs != nil {
+				logs = lo.Map(build.Logs.Entries, func(e zeetv0.DeploymentBuildLogsBuildLogsEntriesLogEntry, _ int) string { return e.Text })
+			}
+		}
+	} else {
+		if result, err := zeetv0.DeploymentDeployLogsQuery(ctx, client.Client(), deployment.Id); err == nil &&
+			result.CurrentUser.Deployment != nil && result.CurrentUser.Deployment.DeployStage != nil &&
+			result.CurrentUser.Deployment.DeployStage.Logs != nil {
+			logs = lo.Map(result.CurrentUser.Deployment.DeployStage.Logs.Entries, func(e zeetv0.DeploymentDeployLogsCurrentUserDeploymentDeployStagePipelineStageLogsEntriesLogEntry, _ int) string {
+				return e.Text
+			})
+		}
+	}
+
+	return &waitFailedError{
+		summary: "Deployment Failed",
+		detail:  failureDetail(fmt.Sprintf("Deployment %s finished with status %s", deployment.Id, deployment.Status), reasons, logs),
+	}
+}
+
+// latestWorkflowRunId returns the identifier of the most recent run of a workflow project, or uuid.Nil.
+func latestWorkflowRunId(ctx context.Context, client *zeetClient, teamId uuid.UUID, projectId uuid.UUID) (uuid.UUID, error) {
+	result, err := zeetv1.WorkflowRunsQuery(ctx, client.ClientV1(), teamId, projectId, zeetv1.PageInput{First: lo.ToPtr(10)})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if result.Team == nil || result.Team.Project == nil || result.Team.Project.Workflow == nil ||
+		len(result.Team.Project.Workflow.Runs.Nodes) == 0 {
+		return uuid.Nil, nil
+	}
+	latest := lo.MaxBy(result.Team.Project.Workflow.Runs.Nodes, func(a, b zeetv1.WorkflowRunsTeamProjectWorkflowRunsWorkflowRunConnectionNodesWorkflowRun) bool {
+		return a.Sequence > b.Sequence
+	})
+	return latest.Id, nil
+}
+
+// waitForWorkflowRun waits for the workflow run started after previousId to finish, it returns a *waitFailedError
+// when the run fails. It doesn't wait when no run started within startTimeout.
+func waitForWorkflowRun(ctx context.Context, client *zeetClient, teamId uuid.UUID, projectId uuid.UUID, previousId uuid.UUID, startTimeout time.Duration) error {
+	start := time.Now()
+	runId := uuid.Nil
+
+	return poll(ctx, func() (bool, error) {
+		if runId == uuid.Nil {
+			id, err := latestWorkflowRunId(ctx, client, teamId, projectId)
+			if err != nil {
+				return false, err
+			}
+			if id == uuid.Nil || id == previousId {
+				if time.Since(start) >= startTimeout {
+					tflog.Warn(ctx, "No workflow run started, not waiting")
+					return true, nil
+				}
+				return false, nil
+			}
+			runId = id
+		}
+
+		result, err := zeetv1.WorkflowRunDetailQuery(ctx, client.ClientV1(), teamId, projectId, runId)
+		if err != nil {
+			return false, err
+		}
+		if result.Team == nil || result.Team.Project == nil || result.Team.Project.Workflow == nil {
+			return false, fmt.Errorf("workflow run %s not found", runId)
+		}
+		run := result.Team.Project.Workflow.Run
+
+		tflog.Debug(ctx, "Waiting for workflow run", map[string]any{"id": runId.String(), "status": run.Status})
+		switch run.Status {
+		case zeetv1.WorkflowRunStatusCompleted:
+			return true, nil
+		case zeetv1.WorkflowRunStatusFailed, zeetv1.WorkflowRunStatusAborted:
+			return true, workflowRunFailure(ctx, client, teamId, projectId, &run.WorkflowRunDetail)
+		default:
+			return false, nil
+		}
+	})
+}
+
+func workflowRunFailure(ctx context.Context, client *zeetClient, teamId uuid.UUID, projectId uuid.UUID, run *zeetv1.WorkflowRunDetail) error {
+	reasons := []string{}
+	var logs []string
+	for _, step := range run.Steps {
+		if step == nil {
+			continue
+		}
+		s := *step
+		switch s.GetStatus() {
+		case zeetv1.WorkflowRunStepStatusFailed, zeetv1.WorkflowRunStepStatusError, zeetv1.WorkflowRunStepStatusAborted:
+		default:
+			continue
+		}
+
+		reason := fmt.Sprintf("step %s %s", s.GetAction(), strings.ToLower(string(s.GetStatus())))
+		if s.GetExecutionError() != nil {
+			reason += ": " + *s.GetExecutionError()
+		}
+		reasons = append(reasons, reason)
+
+		// logs are best effort, the failure is reported without them
+		if logs == nil {
+			logs = workflowRunStepLogs(ctx, client, teamId, projectId, run.Id, s.GetId())
+		}
+	}
+
+	return &waitFailedError{
+		summary: "Workflow Run Failed",
+		detail:  failureDetail(fmt.Sprintf("Workflow run #%d finished with status %s", run.Sequence, run.Status), reasons, logs),
+	}
+}
+
+func workflowRunStepLogs(ctx context.Context, client *zeetClient, teamId uuid.UUID, projectId uuid.UUID, runId uuid.UUID, stepId uuid.UUID) []string {
+	result, err := zeetv1.WorkflowRunDetailLogsQuery(ctx, client.ClientV1(), teamId, projectId, runId, stepId)
+	if err != nil || result.Team == nil || result.Team.Project == nil || result.Team.Project.Workflow == nil {
+		return nil
+	}
+
+	switch step := result.Team.Project.Workflow.Run.Step.(type) {
+	case *zeetv1.WorkflowRunDetailLogsTeamProjectWorkflowRunStepJobRunStep:
+		if step.Logs != nil {
+			return lo.Map(step.Logs.Entries, func(e zeetv1.WorkflowRunDetailLogsTeamProjectWorkflowRunStepJobRunStepLogsEntriesLogEntry, _ int) string {
+				return e.Text
+			})
+		}
+	case *zeetv1.WorkflowRunDetailLogsTeamProjectWorkflowRunStepBuildRunStep:
+		if step.Logs != nil {
+			return lo.Map(step.Logs.Entries, func(e zeetv1.WorkflowRunDetailLogsTeamProjectWorkflowRunStepBuildRunStepLogsEntriesLogEntry, _ int) string {
+				return e.Text
+			})
+		}
+	}
+	return nil
+}
+
+func failureDetail(status string, reasons []string, logs []string) string {
+	detail := status
+	if len(reasons) > 0 {
+		detail += ": " + strings.Join(lo.Uniq(reasons), "; ")
+	}
+	if len(logs) > maxFailureLogLines {
+		logs = logs[len(logs)-maxFailureLogLines:]
+	}
+	if len(logs) > 0 {
+		detail += "\n\nLast log lines:\n" + strings.Join(logs, "\n")
+	}
+	return detail
+}
+
+// addWaitError reports a failed wait for a deployment or workflow run.
+func addWaitError(diags *diag.Diagnostics, action string, err error) {
+	if failed, ok := err.(*waitFailedError); ok {
+		diags.AddError(failed.summary, failed.detail)
+		return
+	}
+	addClientError(diags, action, err)
+}
+
+// projectRollout identifies the latest deployment or workflow run of a project.
+type projectRollout struct {
+	deploymentId  string
+	workflowRunId uuid.UUID
+}
+
+// latestRollout returns the latest rollout of the project when it will be waited for.
+func (r *ProjectResource) latestRollout(ctx context.Context, data *ProjectResourceModel) (projectRollout, error) {
+	var rollout projectRollout
+	var err error
+	if data.IsContainer() && data.WaitForDeploy.ValueBool() {
+		rollout.deploymentId, err = latestDeploymentId(ctx, r.client, data.Container.RepoId.ValueUUID())
+	} else if data.IsWorkflow() && data.WaitForWorkflowRun.ValueBool() {
+		rollout.workflowRunId, err = latestWorkflowRunId(ctx, r.client, data.TeamId.ValueUUID(), data.Id.ValueUUID())
+	}
+	return rollout, err
+}
+
+// waitForRollout waits for the rollout started after previous when enabled by wait_for_deploy or
+// wait_for_workflow_run, paused projects don't roll out. When the change is not expected to roll out,
+// only a rollout that already started is waited for.
+func (r *ProjectResource) waitForRollout(ctx context.Context, data *ProjectResourceModel, previous projectRollout, expected bool, diags *diag.Diagnostics) {
+	if !data.Enabled.ValueBool() {
+		return
+	}
+
+	startTimeout := time.Duration(0)
+	if expected {
+		startTimeout = waitStartGracePeriod
+	}
+
+	if data.IsContainer() && data.WaitForDeploy.ValueBool() {
+		if err := waitForDeployment(ctx, r.client, data.Container.RepoId.ValueUUID(), previous.deploymentId, startTimeout); err != nil {
+			addWaitError(diags, "wait for deployment", err)
+		}
+	} else if data.IsWorkflow() && data.WaitForWorkflowRun.ValueBool() {
+		if err := waitForWorkflowRun(ctx, r.client, data.TeamId.ValueUUID(), data.Id.ValueUUID(), previous.workflowRunId, startTimeout); err != nil {
+			addWaitError(diags, "wait for workflow run", err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+)
+
+// The projectDetail query of the SDK does not select the step definitions of the workflow.
+const projectWorkflowStepsOperation = `
+query projectWorkflowSteps ($teamId: UUID!, $projectId: UUID!) {
+	team(id: $teamId) {
+		id
+		project(id: $projectId) {
+			id
+			workflow {
+				id
+				steps {
+					action
+					dependsOn
+					disabled
+					matchingRule {
+						label
+						entityId
+						branchName
+					}
+					sequenceNumber
+				}
+			}
+		}
+	}
+}
+`
+
+func projectWorkflowStepsQuery(ctx context.Context, client graphql.Client, teamId, projectId uuid.UUID) ([]zeetv1.WorkflowStepDefinitionInput, error) {
+	var data struct {
+		Team *struct {
+			Project *struct {
+				Workflow *struct {
+					Steps []zeetv1.WorkflowStepDefinitionInput `json:"steps"`
+				} `json:"workflow"`
+			} `json:"project"`
+		} `json:"team"`
+	}
+	err := client.MakeRequest(ctx, &graphql.Request{
+		OpName: "projectWorkflowSteps",
+		Query:  projectWorkflowStepsOperation,
+		Variables: map[string]any{
+			"teamId":    teamId,
+			"projectId": projectId,
+		},
+	}, &graphql.Response{Data: &data})
+	if err != nil || data.Team == nil || data.Team.Project == nil || data.Team.Project.Workflow == nil {
+		return nil, err
+	}
+	return data.Team.Project.Workflow.Steps, nil
+}
+
+// readWorkflowSteps reads the workflow steps of a project, dropping the keys the previous steps don't set.
+// Imported steps have no previous value, only the disabled flag of the enabled steps is dropped.
+func readWorkflowSteps(ctx context.Context, client graphql.Client, teamId, projectId uuid.UUID, prev jsontypes.Normalized) (jsontypes.Normalized, error) {
+	steps, err := projectWorkflowStepsQuery(ctx, client, teamId, projectId)
+	if err != nil {
+		return prev, err
+	}
+	if steps == nil {
+		steps = []zeetv1.WorkflowStepDefinitionInput{}
+	}
+	if prev.IsNull() {
+		for i := range steps {
+			if steps[i].Disabled != nil && !*steps[i].Disabled {
+				steps[i].Disabled = nil
+			}
+		}
+		valJson, err := json.Marshal(steps)
+		if err != nil {
+			return prev, err
+		}
+		return jsontypes.NewNormalizedValue(string(valJson)), nil
+	}
+	valJson, err := pruneJSON(steps, prev.ValueString())
+	if err != nil {
+		return prev, err
+	}
+	return jsontypes.NewNormalizedValue(valJson), nil
+}
@@ -2,15 +2,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/zeet-dev/cli/pkg/api"
 )
 
 // Ensure ZeetProvider satisfies various provider interfaces.
@@ -26,8 +32,14 @@ type ZeetProvider struct {
 
 // ZeetProviderModel describes the provider data model.
 type ZeetProviderModel struct {
-	ApiUrl types.String `tfsdk:"api_url"`
-	Token  types.String `tfsdk:"token"`
+	ApiUrl       types.String `tfsdk:"api_url"`
+	Token        types.String `tfsdk:"token"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 func (p *ZeetProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,6 +58,35 @@ func (p *ZeetProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				MarkdownDescription: "The Zeet API token.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of retries of an API request failing with a transient error, defaults to %d. Set to 0 to disable retries.", defaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Minimum wait before retrying a failed API request as a duration e.g. `500ms`, defaults to `%s`. The wait doubles on every retry.", defaultRetryMinWait),
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Maximum wait before retrying a failed API request as a duration e.g. `1m`, defaults to `%s`. A `Retry-After` header on rate limited responses takes precedence, up to this maximum.", defaultRetryMaxWait),
+				Optional:            true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests sent per second by the provider, retries included. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests in flight at once, regardless of Terraform parallelism. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -70,11 +111,39 @@ func (p *ZeetProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		token = data.Token.ValueString()
 	}
 
-	client := api.New(
+	retry := &retryTransport{
+		maxRetries: defaultMaxRetries,
+		minWait:    defaultRetryMinWait,
+		maxWait:    defaultRetryMaxWait,
+		next: newLimitTransport(
+			data.MaxRequestsPerSecond.ValueFloat64(),
+			int(data.MaxConcurrentRequests.ValueInt64()),
+			http.DefaultTransport,
+		),
+	}
+	if !data.MaxRetries.IsNull() {
+		retry.maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryMinWait.IsNull() {
+		retry.minWait = parseDurationAttribute(data.RetryMinWait, path.Root("retry_min_wait"), &resp.Diagnostics)
+	}
+	if !data.RetryMaxWait.IsNull() {
+		retry.maxWait = parseDurationAttribute(data.RetryMaxWait, path.Root("retry_max_wait"), &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if retry.minWait > retry.maxWait {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_min_wait"), "Invalid Attribute Value",
+			fmt.Sprintf("retry_min_wait (%s) must not be greater than retry_max_wait (%s)", retry.minWait, retry.maxWait))
+		return
+	}
+
+	client := newZeetClient(
 		apiURL,
 		token,
 		"terraform-"+p.version,
-		false,
+		retry,
 	)
 
 	// Client configuration for data sources and resources
@@ -87,6 +156,8 @@ func (p *ZeetProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewGroupResource,
 		NewGroupSubgroupResource,
 		NewProjectResource,
+		NewProjectDeployResource,
+		NewBlueprintResource,
 	}
 }
 
@@ -96,7 +167,16 @@ func (p *ZeetProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewGroupDataSource,
 		NewGroupSubGroupDataSource,
 		NewBlueprintDataSource,
+		NewBlueprintsDataSource,
+	}
+}
+
+func parseDurationAttribute(value types.String, attr path.Path, diags *diag.Diagnostics) time.Duration {
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d < 0 {
+		diags.AddAttributeError(attr, "Invalid Attribute Value", fmt.Sprintf("Expected a positive duration such as `1s` or `2m`, got: %q", value.ValueString()))
 	}
+	return d
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,73 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+)
+
+func TestAccProviderConcurrencyLimit(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": zeetv1.TeamResponse{
+				Team: &zeetv1.TeamTeam{
+					Id:   testTeamId,
+					Name: "test",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProviderConcurrencyLimitConfig, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeet_team.test.4", "name", "test"),
+					func(s *terraform.State) error {
+						if n := maxInFlight.Load(); n != 1 {
+							return fmt.Errorf("expected at most 1 concurrent request, got %d", n)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccProviderConcurrencyLimitConfig = `
+provider "zeet" {
+  api_url                 = "%s"
+  max_requests_per_second = 100
+  max_concurrent_requests = 1
+}
+
+data "zeet_team" "test" {
+  count = 5
+  id    = "99c11487-1683-4e10-9620-94d9a78a0b67"
+}
+`
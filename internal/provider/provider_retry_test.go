@@ -0,0 +1,93 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
+)
+
+func TestAccProviderRetry(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// every other request fails, alternating between a rate limit and a bad gateway,
+		// the Retry-After wait is bounded by retry_max_wait
+		switch n := requests.Add(1); {
+		case n%4 == 1:
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		case n%4 == 3:
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": zeetv1.TeamResponse{
+				Team: &zeetv1.TeamTeam{
+					Id:   testTeamId,
+					Name: "test",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProviderRetryConfig, server.URL, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeet_team.test", "name", "test"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccProviderRetryConfig, server.URL, 0),
+				ExpectError: regexp.MustCompile(`returned error (429|502)`),
+			},
+		},
+	})
+}
+
+func TestAccProviderRetryInvalidWait(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "zeet" {
+  api_url        = "http://localhost"
+  retry_min_wait = "soon"
+}
+
+data "zeet_team" "test" {
+  id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+}
+`,
+				ExpectError: regexp.MustCompile(`Expected a positive duration`),
+			},
+		},
+	})
+}
+
+const testAccProviderRetryConfig = `
+provider "zeet" {
+  api_url        = "%s"
+  max_retries    = %d
+  retry_min_wait = "10ms"
+  retry_max_wait = "50ms"
+}
+
+data "zeet_team" "test" {
+  id = "99c11487-1683-4e10-9620-94d9a78a0b67"
+}
+`
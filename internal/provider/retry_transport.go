@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/apierrors"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// idempotentMutationPrefixes lists the mutations that can be sent again after the API may have processed them,
+// other mutations (e.g. create) are only retried when the API has certainly not processed them.
+var idempotentMutationPrefixes = []string{"update", "set", "move", "enable", "disable", "delete"}
+
+// retryTransport retries GraphQL requests failing with a transient error, waiting with exponential backoff
+// between attempts.
+type retryTransport struct {
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+	next       http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	op := readGraphQLOperation(req)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		wait, retry := t.retryWait(op, attempt, resp, err)
+		if !retry || req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying Zeet API request", map[string]any{
+			"operation": op.Name,
+			"attempt":   attempt + 1,
+			"wait":      wait.String(),
+			"reason":    reason,
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryWait returns how long to wait before sending the request again, and whether it should be sent again.
+func (t *retryTransport) retryWait(op graphQLOperation, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= t.maxRetries {
+		return 0, false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		// the request never reached the API
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return t.backoff(attempt), true
+		}
+		if op.idempotent() && apierrors.IsRetryable(err) {
+			return t.backoff(attempt), true
+		}
+		return 0, false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		if wait, ok := retryAfter(resp); ok {
+			return min(wait, t.maxWait), true
+		}
+		return t.backoff(attempt), true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if op.idempotent() {
+			return t.backoff(attempt), true
+		}
+	}
+	return 0, false
+}
+
+// backoff returns the exponential backoff for the attempt, with jitter to spread concurrent retries.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := t.maxWait
+	if attempt < 32 && t.minWait<<attempt < t.maxWait {
+		wait = t.minWait << attempt
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// retryAfter parses the Retry-After header, either a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// graphQLOperation is the GraphQL operation sent in a request body.
+type graphQLOperation struct {
+	Name  string `json:"operationName"`
+	Query string `json:"query"`
+}
+
+func (op graphQLOperation) idempotent() bool {
+	if !strings.HasPrefix(strings.TrimSpace(op.Query), "mutation") {
+		return true
+	}
+	for _, prefix := range idempotentMutationPrefixes {
+		if strings.HasPrefix(op.Name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func readGraphQLOperation(req *http.Request) graphQLOperation {
+	// requests which can't be read are handled as non idempotent mutations
+	unknown := graphQLOperation{Query: "mutation"}
+	if req.GetBody == nil {
+		return unknown
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return unknown
+	}
+	defer body.Close()
+
+	var op graphQLOperation
+	if err := json.NewDecoder(body).Decode(&op); err != nil {
+		return unknown
+	}
+	return op
+}
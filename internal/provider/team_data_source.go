@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/zeet-dev/cli/pkg/api"
 	zeetv1 "github.com/zeet-dev/cli/pkg/sdk/v1"
 	"github.com/zeet-dev/terraform-provider-zeet/internal/provider/customtypes"
 )
@@ -23,7 +22,7 @@ func NewTeamDataSource() datasource.DataSource {
 
 // TeamDataSource defines the data source implementation.
 type TeamDataSource struct {
-	client *api.Client
+	client *zeetClient
 }
 
 // TeamDataSourceModel describes the data source data model.
@@ -61,12 +60,12 @@ func (d *TeamDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*zeetClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *zeetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -87,7 +86,7 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	result, err := zeetv1.TeamQuery(ctx, d.client.ClientV1(), data.Id.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read team", err)
 		return
 	}
 
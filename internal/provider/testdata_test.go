@@ -9,11 +9,12 @@ var (
 	testTeamId      = uuid.MustParse("99c11487-1683-4e10-9620-94d9a78a0b67")
 	testGroupId     = uuid.MustParse("ddf9093e-cc11-46a5-82c7-fc99fc44ef93")
 	testSubGroupId  = uuid.MustParse("149ad8a9-cb35-477b-bbac-39a39f146074")
+	testSubGroupId2 = uuid.MustParse("0b8f6c1e-4a7d-4f3e-9d2a-6c5b1e8f7a90")
 	testBlueprintId = uuid.MustParse("2e9aa322-3a41-4930-9f3c-2987836d3b70")
 	testProjectId   = uuid.MustParse("69a5f7df-048d-4fc3-885d-178cdcb9b180")
 	testWorkflowId  = uuid.MustParse("2a46bfb8-914f-4351-a283-7630463f75ea")
 	testDeployId    = uuid.MustParse("54adc3b5-319b-4b40-a023-36ddcba7add8")
 	testRepoId      = uuid.MustParse("17e2834e-1188-4255-ac85-8e31918e8950")
-	// testCloudId     = uuid.MustParse("0eac67f1-f44a-4d4f-8962-2c126f353259")
-	testClusterId = uuid.MustParse("5a0e108d-6df6-456d-aa3a-a89e78b57cf6")
+	testCloudId     = uuid.MustParse("0eac67f1-f44a-4d4f-8962-2c126f353259")
+	testClusterId   = uuid.MustParse("5a0e108d-6df6-456d-aa3a-a89e78b57cf6")
 )